@@ -0,0 +1,163 @@
+package block
+
+import (
+	"encoding/hex"
+	"log"
+	"sort"
+
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	consensusExtraDataKey = "Consensus"
+
+	nextRoundMethodName = "NextRound"
+	nextTermMethodName  = "NextTerm"
+)
+
+func convertConsensusInfo(header *aelf.BlockHeader) *pbaelf.ConsensusInfo {
+	data, found := header.ExtraData[consensusExtraDataKey]
+	if !found || len(data) == 0 {
+		return nil
+	}
+
+	var information aelf.AElfConsensusHeaderInformation
+	if err := proto.Unmarshal(data, &information); err != nil {
+		log.Printf("Failed to unmarshal consensus extra data at height %d: %v", header.Height, err)
+		return nil
+	}
+	return newConsensusInfo(&information)
+}
+
+func newConsensusInfo(information *aelf.AElfConsensusHeaderInformation) *pbaelf.ConsensusInfo {
+	round := information.Round
+	if round == nil {
+		round = &aelf.Round{}
+	}
+
+	info := &pbaelf.ConsensusInfo{
+		SenderPubkey:                          hex.EncodeToString(information.SenderPubkey),
+		Behaviour:                             pbaelf.ConsensusBehaviour(information.Behaviour),
+		RoundNumber:                           round.RoundNumber,
+		TermNumber:                            round.TermNumber,
+		Miners:                                convertMiners(round.RealTimeMinersInformation),
+		ExtraBlockProducerOfPreviousRound:     round.ExtraBlockProducerOfPreviousRound,
+		ConfirmedIrreversibleBlockHeight:      round.ConfirmedIrreversibleBlockHeight,
+		ConfirmedIrreversibleBlockRoundNumber: round.ConfirmedIrreversibleBlockRoundNumber,
+		IsMinerListJustChanged:                round.IsMinerListJustChanged,
+		MainChainMinersRoundNumber:            round.MainChainMinersRoundNumber,
+		BlockchainAge:                         round.BlockchainAge,
+		RoundIdForValidation:                  round.RoundIdForValidation,
+	}
+	for _, miner := range info.Miners {
+		if miner.IsExtraBlockProducer {
+			info.ExtraBlockProducer = miner.Pubkey
+			break
+		}
+	}
+	return info
+}
+
+func convertMiners(original map[string]*aelf.MinerInRound) []*pbaelf.Miner {
+	var output []*pbaelf.Miner
+	for pubkey, miner := range original {
+		if miner.Pubkey != "" {
+			pubkey = miner.Pubkey
+		}
+		output = append(output, &pbaelf.Miner{
+			Pubkey:                         pubkey,
+			Order:                          miner.Order,
+			IsExtraBlockProducer:           miner.IsExtraBlockProducer,
+			InValue:                        miner.InValue.ToHex(),
+			OutValue:                       miner.OutValue.ToHex(),
+			Signature:                      miner.Signature.ToHex(),
+			PreviousInValue:                miner.PreviousInValue.ToHex(),
+			ExpectedMiningTime:             miner.ExpectedMiningTime,
+			ActualMiningTimes:              miner.ActualMiningTimes,
+			ProducedBlocks:                 miner.ProducedBlocks,
+			ProducedTinyBlocks:             miner.ProducedTinyBlocks,
+			MissedTimeSlots:                miner.MissedTimeSlots,
+			SupposedOrderOfNextRound:       miner.SupposedOrderOfNextRound,
+			FinalOrderOfNextRound:          miner.FinalOrderOfNextRound,
+			ImpliedIrreversibleBlockHeight: miner.ImpliedIrreversibleBlockHeight,
+		})
+	}
+
+	// Map iteration order is random, sort by mining order so the output is deterministic
+	sort.Slice(output, func(i, j int) bool {
+		if output[i].Order != output[j].Order {
+			return output[i].Order < output[j].Order
+		}
+		return output[i].Pubkey < output[j].Pubkey
+	})
+	return output
+}
+
+// convertConsensusTransition returns a marker when the block starts a new round or term. The header
+// consensus information is authoritative, the `NextRound`/`NextTerm` system transaction is used to attach
+// the consensus contract logs and as a fallback when the header carries no consensus information.
+func convertConsensusTransition(consensus *pbaelf.ConsensusInfo, traces []*pbaelf.TransactionTrace) *pbaelf.ConsensusTransition {
+	trace, call := findConsensusTransitionCall(traces)
+
+	startsNewRound := consensus != nil &&
+		(consensus.Behaviour == pbaelf.ConsensusBehaviour_NEXT_ROUND || consensus.Behaviour == pbaelf.ConsensusBehaviour_NEXT_TERM)
+	if !startsNewRound {
+		if call == nil {
+			return nil
+		}
+
+		// NextRoundInput and NextTermInput share the Round field numbers, the extra random number is skipped
+		var round aelf.Round
+		if err := proto.Unmarshal(call.Params, &round); err != nil {
+			log.Printf("Failed to unmarshal %s input of transaction %s: %v", call.MethodName, trace.TransactionId, err)
+			return nil
+		}
+		behaviour := aelf.AElfConsensusBehaviour_NEXT_ROUND
+		if call.MethodName == nextTermMethodName {
+			behaviour = aelf.AElfConsensusBehaviour_NEXT_TERM
+		}
+		consensus = newConsensusInfo(&aelf.AElfConsensusHeaderInformation{Round: &round, Behaviour: behaviour})
+	}
+
+	transition := &pbaelf.ConsensusTransition{
+		IsNewTerm:                         consensus.Behaviour == pbaelf.ConsensusBehaviour_NEXT_TERM,
+		RoundNumber:                       consensus.RoundNumber,
+		TermNumber:                        consensus.TermNumber,
+		Miners:                            consensus.Miners,
+		IsMinerListJustChanged:            consensus.IsMinerListJustChanged,
+		ExtraBlockProducerOfPreviousRound: consensus.ExtraBlockProducerOfPreviousRound,
+	}
+	if call != nil {
+		transition.TransactionId = trace.TransactionId
+		for _, c := range trace.Calls {
+			for _, l := range c.Logs {
+				if l.Address == call.To {
+					transition.Logs = append(transition.Logs, l)
+				}
+			}
+		}
+	}
+	return transition
+}
+
+func findConsensusTransitionCall(traces []*pbaelf.TransactionTrace) (*pbaelf.TransactionTrace, *pbaelf.Call) {
+	for _, trace := range traces {
+		if int(trace.MainCallIndex) >= len(trace.Calls) {
+			continue
+		}
+		call := trace.Calls[trace.MainCallIndex]
+		if call.IsReverted || call.ExecutionStatus != pbaelf.ExecutionStatus_EXECUTED {
+			continue
+		}
+		// Only the miner generated consensus transactions, a user contract may expose a method of the same name
+		if trace.Kind != pbaelf.TransactionKind_SYSTEM_CONSENSUS {
+			continue
+		}
+		if call.MethodName == nextRoundMethodName || call.MethodName == nextTermMethodName {
+			return trace, call
+		}
+	}
+	return nil, nil
+}
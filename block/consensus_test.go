@@ -0,0 +1,122 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"github.com/test-go/testify/assert"
+	"github.com/test-go/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func testNextTermRound() *aelf.Round {
+	return &aelf.Round{
+		RoundNumber:                       11,
+		TermNumber:                        2,
+		IsMinerListJustChanged:            true,
+		ExtraBlockProducerOfPreviousRound: "04bb",
+		RealTimeMinersInformation: map[string]*aelf.MinerInRound{
+			"04bb": {Pubkey: "04bb", Order: 2},
+			"04aa": {Pubkey: "04aa", Order: 1, IsExtraBlockProducer: true},
+		},
+	}
+}
+
+func TestConvertBlock_ConsensusTransition(t *testing.T) {
+	consensusContract := testAddress(0x01)
+	round := testNextTermRound()
+
+	block := testBlock(100,
+		map[string][]byte{
+			consensusExtraDataKey: mustMarshal(&aelf.AElfConsensusHeaderInformation{
+				SenderPubkey: []byte{0x04, 0xaa},
+				Round:        round,
+				Behaviour:    aelf.AElfConsensusBehaviour_NEXT_TERM,
+			}),
+			systemTransactionCountExtraDataKey: mustMarshal(wrapperspb.Int32(1)),
+		},
+		testTransactionWithTrace{
+			id: testHash(0x10),
+			tx: testTransaction(testAddress(0x02), consensusContract, nextTermMethodName, round),
+			trace: testTrace(aelf.ExecutionStatus_EXECUTED,
+				&aelf.LogEvent{Address: consensusContract, Name: "MiningInformationUpdated"},
+				&aelf.LogEvent{Address: testAddress(0x03), Name: "Transferred"},
+			),
+		},
+	)
+
+	converted := mustConvertBlock(block)
+
+	assert.Equal(t, "04aa", converted.Header.Consensus.SenderPubkey)
+	assert.Equal(t, "04aa", converted.Header.Consensus.ExtraBlockProducer)
+	assert.Equal(t, pbaelf.ConsensusBehaviour_NEXT_TERM, converted.Header.Consensus.Behaviour)
+
+	transition := converted.ConsensusTransition
+	require.NotNil(t, transition)
+	assert.True(t, transition.IsNewTerm)
+	assert.Equal(t, int64(11), transition.RoundNumber)
+	assert.Equal(t, int64(2), transition.TermNumber)
+	assert.True(t, transition.IsMinerListJustChanged)
+	assert.Equal(t, "04bb", transition.ExtraBlockProducerOfPreviousRound)
+	require.Len(t, transition.Miners, 2)
+	assert.Equal(t, "04aa", transition.Miners[0].Pubkey)
+	assert.Equal(t, "04bb", transition.Miners[1].Pubkey)
+	assert.Equal(t, testHash(0x10).ToHex(), transition.TransactionId)
+	require.Len(t, transition.Logs, 1)
+	assert.Equal(t, "MiningInformationUpdated", transition.Logs[0].Name)
+}
+
+func TestConvertBlock_ConsensusTransitionFromTransaction(t *testing.T) {
+	round := testNextTermRound()
+
+	block := testBlock(100, withSystemTransactionCount(1), testTransactionWithTrace{
+		id:    testHash(0x10),
+		tx:    testTransaction(testAddress(0x02), testAddress(0x01), nextRoundMethodName, round),
+		trace: testTrace(aelf.ExecutionStatus_EXECUTED),
+	})
+
+	converted := mustConvertBlock(block)
+
+	assert.Nil(t, converted.Header.Consensus)
+	transition := converted.ConsensusTransition
+	require.NotNil(t, transition)
+	assert.False(t, transition.IsNewTerm)
+	assert.Equal(t, int64(11), transition.RoundNumber)
+	assert.Len(t, transition.Miners, 2)
+}
+
+func TestConvertBlock_NoConsensusTransition(t *testing.T) {
+	block := testBlock(100,
+		map[string][]byte{
+			consensusExtraDataKey: mustMarshal(&aelf.AElfConsensusHeaderInformation{
+				Round:     &aelf.Round{RoundNumber: 10},
+				Behaviour: aelf.AElfConsensusBehaviour_UPDATE_VALUE,
+			}),
+		},
+		testTransactionWithTrace{
+			id:    testHash(0x10),
+			tx:    testTransaction(testAddress(0x02), testAddress(0x01), nextRoundMethodName, testNextTermRound()),
+			trace: testTrace(aelf.ExecutionStatus_CONTRACT_ERROR),
+		},
+	)
+
+	converted := mustConvertBlock(block)
+
+	assert.Equal(t, int64(10), converted.Header.Consensus.RoundNumber)
+	assert.Nil(t, converted.ConsensusTransition)
+}
+
+func TestConvertBlock_NoConsensusTransitionFromUserContract(t *testing.T) {
+	// A user contract exposing a `NextRound` method, called after the system transactions
+	block := testBlock(100, withSystemTransactionCount(0), testTransactionWithTrace{
+		id:    testHash(0x10),
+		tx:    testTransaction(testAddress(0x02), testAddress(0x03), nextRoundMethodName, testNextTermRound()),
+		trace: testTrace(aelf.ExecutionStatus_EXECUTED, &aelf.LogEvent{Address: testAddress(0x03), Name: "MiningInformationUpdated"}),
+	})
+
+	converted := mustConvertBlock(block)
+
+	assert.Equal(t, pbaelf.TransactionKind_USER, converted.TransactionTraces[0].Kind)
+	assert.Nil(t, converted.ConsensusTransition)
+}
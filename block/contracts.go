@@ -0,0 +1,45 @@
+package block
+
+import "sync"
+
+// Chain ids of the AElf main chain (AELF) and of its tDVV side chain.
+const (
+	MainChainId int32 = 9992731
+	TDVVChainId int32 = 1866392
+)
+
+// SystemContracts are the base58 addresses of the system contracts whose calls and events the converter
+// decodes, method and event names being only unique within a contract.
+type SystemContracts struct {
+	MultiToken string
+	CrossChain string
+}
+
+var (
+	systemContractsLock sync.RWMutex
+	systemContracts     = map[int32]SystemContracts{
+		MainChainId: {
+			MultiToken: "JRmBduh4nXWi1aXgdUsj5gJrzeZb2LxmrAbf7W99faZSvoAaE",
+			CrossChain: "2SQ9LeGZYSWmfJcYuQkDQxgd3HzwjamAaaL4Tge2eFSXw2cseq",
+		},
+		TDVVChainId: {
+			MultiToken: "7RzVGiuVWkvL4VfVHdZfQF2Tri3sgLe9U991bohHFfSRZXuGX",
+			CrossChain: "2snHc8AMh9QMbCAa7XXmdZZVM5EBZUUPDdLjemwUJkBnL6k8z9",
+		},
+	}
+)
+
+// RegisterSystemContracts sets the system contracts of the chain chainId, overriding the known ones. The
+// blocks of a chain without system contracts have no cross chain data.
+func RegisterSystemContracts(chainId int32, contracts SystemContracts) {
+	systemContractsLock.Lock()
+	defer systemContractsLock.Unlock()
+	systemContracts[chainId] = contracts
+}
+
+// SystemContractsOf returns the system contracts of the chain chainId, empty when they are unknown.
+func SystemContractsOf(chainId int32) SystemContracts {
+	systemContractsLock.RLock()
+	defer systemContractsLock.RUnlock()
+	return systemContracts[chainId]
+}
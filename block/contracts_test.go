@@ -0,0 +1,21 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	"github.com/test-go/testify/assert"
+	"github.com/test-go/testify/require"
+)
+
+func TestSystemContracts(t *testing.T) {
+	for _, chainId := range []int32{MainChainId, TDVVChainId} {
+		contracts := SystemContractsOf(chainId)
+		for _, address := range []string{contracts.MultiToken, contracts.CrossChain} {
+			_, err := aelf.AddressFromBase58(address)
+			require.NoError(t, err, "chain %d contract %q", chainId, address)
+		}
+	}
+
+	assert.Equal(t, SystemContracts{}, SystemContractsOf(1))
+}
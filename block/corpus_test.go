@@ -0,0 +1,382 @@
+package block
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	"github.com/test-go/testify/assert"
+	"github.com/test-go/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	update = flag.Bool("update", false, "rewrite the synthetic fixtures and the golden outputs of testdata/corpus")
+	record = flag.String("record", "", "record the `name=path` fixture of testdata/corpus from the FIRE BLOCK line of an instrumented node output")
+)
+
+const corpusDir = "testdata/corpus"
+
+// corpusFixture is a serialized aelf.Block of testdata/corpus, `<name>.pb`, and the output of its conversion,
+// `<name>.golden.json`. Recorded fixtures were produced by a node, synthetic fixtures are only extra cases
+// built by build, kept apart in testdata/corpus/synthetic and written from build on -update.
+type corpusFixture struct {
+	name      string
+	blockHash string
+	build     func() *aelf.Block
+}
+
+func (f corpusFixture) path(extension string) string {
+	if f.build != nil {
+		return filepath.Join(corpusDir, "synthetic", f.name+extension)
+	}
+	return filepath.Join(corpusDir, f.name+extension)
+}
+
+var corpus = []corpusFixture{
+	// Recorded from a local AElf node (chain AELF): consensus tiny block and resource token donation
+	{name: "tiny-block", blockHash: "1565beb096ff73391ae828395fddb355c70dab49e943909b31555eaaf08b80fd"},
+
+	// Synthetic, built by the test for the cases still missing a recorded block, they only check the converter
+	// against these builders, not against the output of a node
+	{name: "failed-transaction", blockHash: "f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1", build: corpusFailedTransaction},
+	{name: "inline-calls", blockHash: "c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2c2", build: corpusInlineCalls},
+	{name: "pre-post-plugins", blockHash: "b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3b3", build: corpusPrePostPlugins},
+	{name: "cross-chain-indexing", blockHash: "c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4c4", build: corpusCrossChainIndexing},
+	{name: "contract-deployment", blockHash: "d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5d5", build: corpusContractDeployment},
+	{name: "next-term", blockHash: "e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6", build: corpusNextTerm},
+}
+
+// TestConvertBlock_Corpus compares the conversion of the fixtures of testdata/corpus with their golden
+// output, `go test ./block -run Corpus -update` rewrites them after a conversion change.
+func TestConvertBlock_Corpus(t *testing.T) {
+	for _, fixture := range corpus {
+		t.Run(fixture.name, func(t *testing.T) {
+			blockPath := fixture.path(".pb")
+			if *update && fixture.build != nil {
+				data, err := proto.MarshalOptions{Deterministic: true}.Marshal(fixture.build())
+				require.NoError(t, err)
+				require.NoError(t, os.WriteFile(blockPath, data, 0644))
+			}
+
+			data, err := os.ReadFile(blockPath)
+			require.NoError(t, err)
+			converted, err := ConvertRawBlock(fixture.blockHash, data, LatestVersion)
+			require.NoError(t, err)
+
+			output, err := protojson.Marshal(converted)
+			require.NoError(t, err)
+			// protojson randomizes its whitespaces, the output is re-indented to be stable
+			var golden bytes.Buffer
+			require.NoError(t, json.Indent(&golden, output, "", "  "))
+			golden.WriteByte('\n')
+
+			goldenPath := fixture.path(".golden.json")
+			if *update {
+				require.NoError(t, os.WriteFile(goldenPath, golden.Bytes(), 0644))
+			}
+			expected, err := os.ReadFile(goldenPath)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), golden.String(), "conversion of %s differs from its golden output, run with -update to rewrite it", blockPath)
+		})
+	}
+}
+
+// substreamsFixture is the converted tiny-block fixture the substreams crate tests decode, rewritten with
+// the golden outputs on -update.
+const substreamsFixture = "../substreams/fixtures/block_97.binpb"
+
+func TestConvertBlock_SubstreamsFixture(t *testing.T) {
+	fixture := corpus[0]
+	require.Equal(t, "tiny-block", fixture.name)
+
+	data, err := os.ReadFile(fixture.path(".pb"))
+	require.NoError(t, err)
+	converted, err := ConvertRawBlock(fixture.blockHash, data, LatestVersion)
+	require.NoError(t, err)
+	expected, err := proto.MarshalOptions{Deterministic: true}.Marshal(converted)
+	require.NoError(t, err)
+
+	if *update {
+		require.NoError(t, os.WriteFile(substreamsFixture, expected, 0644))
+	}
+	actual, err := os.ReadFile(substreamsFixture)
+	require.NoError(t, err)
+	assert.True(t, bytes.Equal(expected, actual), "%s is not the conversion of %s, run with -update to rewrite it", substreamsFixture, fixture.path(".pb"))
+}
+
+func TestConvertBlock_CorpusFiles(t *testing.T) {
+	paths := map[string]bool{filepath.Join(corpusDir, "synthetic"): true}
+	for _, fixture := range corpus {
+		paths[fixture.path(".pb")], paths[fixture.path(".golden.json")] = true, true
+	}
+
+	for _, dir := range []string{corpusDir, filepath.Join(corpusDir, "synthetic")} {
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		for _, entry := range entries {
+			assert.True(t, paths[filepath.Join(dir, entry.Name())], "%s is not a fixture of the corpus", filepath.Join(dir, entry.Name()))
+		}
+	}
+}
+
+// TestRecordCorpusFixture writes the recorded fixture `<name>.pb` of testdata/corpus from the FIRE BLOCK line
+// of an instrumented node output, `go test ./block -run RecordCorpus -record <name>=<file>`. The fixture is
+// then added to the corpus with the logged block hash and its golden output written with -update.
+func TestRecordCorpusFixture(t *testing.T) {
+	if *record == "" {
+		t.Skip("no fixture to record, see -record")
+	}
+
+	name, path, found := strings.Cut(*record, "=")
+	require.True(t, found, "invalid -record %q, expected name=path", *record)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	blockHash, data, err := parseFireBlockLine(string(content))
+	require.NoError(t, err)
+
+	_, err = ConvertRawBlock(blockHash, data, LatestVersion)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(corpusDir, name+".pb"), data, 0644))
+	t.Logf("recorded %s, add it to the corpus with: {name: %q, blockHash: %q}", name, name, blockHash)
+}
+
+// parseFireBlockLine returns the hash and the payload of the first `FIRE BLOCK <num> <id> <parent num>
+// <parent id> <lib num> <timestamp> <payload>` line of content.
+func parseFireBlockLine(content string) (blockHash string, payload []byte, err error) {
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != "FIRE" || fields[1] != "BLOCK" {
+			continue
+		}
+		if len(fields) != 9 {
+			return "", nil, fmt.Errorf("invalid FIRE BLOCK line, expected 9 fields, got %d", len(fields))
+		}
+
+		payload, err := base64.StdEncoding.DecodeString(fields[8])
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid FIRE BLOCK payload: %w", err)
+		}
+		return fields[3], payload, nil
+	}
+	return "", nil, fmt.Errorf("no FIRE BLOCK line found")
+}
+
+func TestParseFireBlockLine(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join(corpusDir, "tiny-block.pb"))
+	require.NoError(t, err)
+
+	content := "FIRE INIT 3.0 type.googleapis.com/aelf.Block\n" +
+		"FIRE BLOCK 16 aa 15 bb 8 1732172217000000000 " + base64.StdEncoding.EncodeToString(data) + "\n"
+	blockHash, payload, err := parseFireBlockLine(content)
+	require.NoError(t, err)
+	assert.Equal(t, "aa", blockHash)
+	assert.Equal(t, data, payload)
+
+	_, _, err = parseFireBlockLine("FIRE BLOCK 16 aa 15\n")
+	require.Error(t, err)
+	_, _, err = parseFireBlockLine("FIRE INIT 3.0 type.googleapis.com/aelf.Block\n")
+	require.Error(t, err)
+}
+
+// corpusSystemTransactions returns the UpdateValue consensus transaction miners put at the beginning of
+// their blocks, with the extra data recording it as the only system transaction.
+func corpusSystemTransactions(miner *aelf.Address, consensusContract *aelf.Address) (map[string][]byte, testTransactionWithTrace) {
+	extraData := map[string][]byte{systemTransactionCountExtraDataKey: mustMarshal(&wrapperspb.Int32Value{Value: 1})}
+	return extraData, corpusTransaction(testTransaction(miner, consensusContract, "UpdateValue", &aelf.Hash{Value: testHash(0x99).Value}),
+		testTrace(aelf.ExecutionStatus_EXECUTED, &aelf.LogEvent{Address: consensusContract, Name: "MiningInformationUpdated"}))
+}
+
+func corpusTransaction(tx *aelf.Transaction, trace *aelf.TransactionTrace) testTransactionWithTrace {
+	return testTransactionWithTrace{id: testTransactionId(tx), tx: tx, trace: trace}
+}
+
+func corpusFeeCharging(sender, tokenContract *aelf.Address, symbol string, amount int64) (*aelf.Transaction, *aelf.TransactionTrace) {
+	return testTransaction(sender, tokenContract, "ChargeTransactionFees", nil), testTrace(aelf.ExecutionStatus_EXECUTED,
+		testEvent(tokenContract, transactionFeeChargedEventName, nil, &aelf.TransactionFeeCharged{Symbol: symbol, Amount: amount, ChargingAddress: sender}))
+}
+
+func corpusTransferred(tokenContract, from, to *aelf.Address, symbol string, amount int64) *aelf.LogEvent {
+	return testEvent(tokenContract, "Transferred",
+		[]proto.Message{&aelf.Transferred{From: from}, &aelf.Transferred{To: to}, &aelf.Transferred{Symbol: symbol}},
+		&aelf.Transferred{Amount: amount, Memo: "corpus"})
+}
+
+// corpusFailedTransaction is a transfer failing on an insufficient balance: its fee is charged but its inline
+// call is reverted.
+func corpusFailedTransaction() *aelf.Block {
+	miner, consensusContract := testAddress(0x01), testAddress(0x02)
+	tokenContract, _ := testSystemContracts()
+	sender, contract := testAddress(0x10), testAddress(0x11)
+	extraData, system := corpusSystemTransactions(miner, consensusContract)
+
+	trace := testTrace(aelf.ExecutionStatus_CONTRACT_ERROR)
+	trace.Error = "AElf.Sdk.CSharp.AssertionException: Insufficient balance. ELF: 10; Need amount: 100"
+	trace.PreTransactions, trace.PreTraces = oneTransaction(corpusFeeCharging(sender, tokenContract, "ELF", 3200000))
+	trace.InlineTransactions, trace.InlineTraces = oneTransaction(testTransaction(contract, tokenContract, "Transfer", &aelf.TransferInput{To: testAddress(0x12), Symbol: "ELF", Amount: 100}),
+		testTrace(aelf.ExecutionStatus_CONTRACT_ERROR))
+	trace.InlineTraces[0].Error = "Insufficient balance"
+
+	return testBlock(1000, extraData, system, corpusTransaction(testTransaction(sender, contract, "Buy", &aelf.Hash{Value: testHash(0x13).Value}), trace))
+}
+
+// corpusInlineCalls is a swap calling two nested levels of inline transfers, with their logs and state changes.
+func corpusInlineCalls() *aelf.Block {
+	miner, consensusContract := testAddress(0x01), testAddress(0x02)
+	tokenContract, _ := testSystemContracts()
+	sender, swapContract, pool := testAddress(0x10), testAddress(0x11), testAddress(0x12)
+	extraData, system := corpusSystemTransactions(miner, consensusContract)
+
+	transferIn := testTrace(aelf.ExecutionStatus_EXECUTED, corpusTransferred(tokenContract, sender, pool, "ELF", 1000))
+	transferIn.StateSet.Writes = map[string][]byte{"Balances/ELF/sender": {0x01}, "Balances/ELF/pool": {0x02}}
+	transferIn.StateSet.Reads = map[string]bool{"Balances/ELF/sender": true, "Balances/ELF/pool": true}
+	transferOut := testTrace(aelf.ExecutionStatus_EXECUTED, corpusTransferred(tokenContract, pool, sender, "USDT", 42))
+	transferOut.StateSet.Writes = map[string][]byte{"Balances/USDT/sender": {0x03}, "Balances/USDT/pool": {0x04}}
+	transferOut.StateSet.Deletes = map[string]bool{"Allowances/USDT/pool": true}
+
+	swap := testTrace(aelf.ExecutionStatus_EXECUTED, testEvent(swapContract, "Swap", nil, &aelf.Hash{Value: testHash(0x13).Value}))
+	swap.StateSet.Writes = map[string][]byte{"Pairs/ELF-USDT": {0x05}}
+	swap.InlineTransactions = []*aelf.Transaction{
+		testTransaction(swapContract, tokenContract, "TransferFrom", &aelf.TransferInput{To: pool, Symbol: "ELF", Amount: 1000}),
+		testTransaction(pool, tokenContract, "Transfer", &aelf.TransferInput{To: sender, Symbol: "USDT", Amount: 42}),
+	}
+	swap.InlineTraces = []*aelf.TransactionTrace{transferIn, transferOut}
+
+	trace := testTrace(aelf.ExecutionStatus_EXECUTED)
+	trace.PreTransactions, trace.PreTraces = oneTransaction(corpusFeeCharging(sender, tokenContract, "ELF", 4500000))
+	trace.InlineTransactions, trace.InlineTraces = oneTransaction(testTransaction(testAddress(0x14), swapContract, "SwapExactTokensForTokens", nil), swap)
+
+	return testBlock(1001, extraData, system, corpusTransaction(testTransaction(sender, testAddress(0x14), "Swap", nil), trace))
+}
+
+// corpusPrePostPlugins has a transaction with fee and resource charging pre and post plugins, then one whose
+// post plugin fails.
+func corpusPrePostPlugins() *aelf.Block {
+	miner, consensusContract := testAddress(0x01), testAddress(0x02)
+	tokenContract, _ := testSystemContracts()
+	sender, contract := testAddress(0x10), testAddress(0x11)
+	extraData, system := corpusSystemTransactions(miner, consensusContract)
+
+	withPlugins := func(postStatus aelf.ExecutionStatus) *aelf.TransactionTrace {
+		trace := testTrace(aelf.ExecutionStatus_EXECUTED, &aelf.LogEvent{Address: contract, Name: "ValueSet", NonIndexed: []byte{0x08, 0x01}})
+		preTx, preTrace := corpusFeeCharging(sender, tokenContract, "ELF", 1000000)
+		trace.PreTransactions = []*aelf.Transaction{preTx, testTransaction(sender, tokenContract, "CheckResourceToken", nil)}
+		trace.PreTraces = []*aelf.TransactionTrace{preTrace, testTrace(aelf.ExecutionStatus_EXECUTED)}
+		trace.PostTransactions, trace.PostTraces = oneTransaction(testTransaction(sender, tokenContract, "ChargeResourceToken", nil), testTrace(postStatus))
+		if postStatus != aelf.ExecutionStatus_EXECUTED {
+			trace.ExecutionStatus = aelf.ExecutionStatus_POSTFAILED
+			trace.PostTraces[0].Error = "Insufficient resource token"
+		}
+		return trace
+	}
+
+	return testBlock(1002, extraData, system,
+		corpusTransaction(testTransaction(sender, contract, "SetValue", &wrapperspb.Int64Value{Value: 1}), withPlugins(aelf.ExecutionStatus_EXECUTED)),
+		corpusTransaction(testTransaction(sender, contract, "SetValue", &wrapperspb.Int64Value{Value: 2}), withPlugins(aelf.ExecutionStatus_CONTRACT_ERROR)),
+	)
+}
+
+// corpusCrossChainIndexing is a main chain block releasing the indexing of side chain blocks, with a cross
+// chain transfer.
+func corpusCrossChainIndexing() *aelf.Block {
+	tokenContract, crossChainContract := testSystemContracts()
+	miner, consensusContract, parliamentContract := testAddress(0x01), testAddress(0x02), testAddress(0x04)
+	sender, receiver := testAddress(0x10), testAddress(0x11)
+	extraData, system := corpusSystemTransactions(miner, consensusContract)
+	extraData[systemTransactionCountExtraDataKey] = mustMarshal(&wrapperspb.Int32Value{Value: 2})
+	extraData[crossChainExtraDataKey] = mustMarshal(&aelf.CrossChainExtraData{TransactionStatusMerkleTreeRoot: testHash(0x20)})
+
+	release := testTrace(aelf.ExecutionStatus_EXECUTED)
+	release.InlineTransactions, release.InlineTraces = oneTransaction(
+		testTransaction(crossChainContract, parliamentContract, "Release", testHash(0x25)),
+		testTrace(aelf.ExecutionStatus_EXECUTED, &aelf.LogEvent{Address: parliamentContract, Name: "ProposalReleased"}))
+	release.InlineTraces[0].InlineTransactions, release.InlineTraces[0].InlineTraces = oneTransaction(
+		testTransaction(parliamentContract, crossChainContract, recordCrossChainDataMethodName, &aelf.RecordCrossChainDataInput{
+			ProposedCrossChainData: &aelf.CrossChainBlockData{
+				SideChainBlockDataList: []*aelf.SideChainBlockData{
+					{Height: 4200, ChainId: 1866392, BlockHeaderHash: testHash(0x21), TransactionStatusMerkleTreeRoot: testHash(0x22)},
+					{Height: 4201, ChainId: 1866392, BlockHeaderHash: testHash(0x23), TransactionStatusMerkleTreeRoot: testHash(0x24)},
+				},
+			},
+			Proposer: miner,
+		}),
+		testTrace(aelf.ExecutionStatus_EXECUTED, &aelf.LogEvent{Address: crossChainContract, Name: "SideChainBlockDataIndexed"}))
+	indexing := corpusTransaction(testTransaction(miner, crossChainContract, "ReleaseCrossChainIndexingProposal", nil), release)
+
+	transfer := testTrace(aelf.ExecutionStatus_EXECUTED,
+		testEvent(tokenContract, crossChainTransferredEventName,
+			[]proto.Message{&aelf.CrossChainTransferred{From: sender}, &aelf.CrossChainTransferred{To: receiver}, &aelf.CrossChainTransferred{Symbol: "ELF"}, &aelf.CrossChainTransferred{Amount: 500000000}},
+			&aelf.CrossChainTransferred{Memo: "to side chain", ToChainId: 1866392, IssueChainId: 9992731},
+		))
+	transfer.PreTransactions, transfer.PreTraces = oneTransaction(corpusFeeCharging(sender, tokenContract, "ELF", 2000000))
+
+	return testBlock(1003, extraData, system, indexing,
+		corpusTransaction(testTransaction(sender, tokenContract, "CrossChainTransfer", nil), transfer))
+}
+
+// corpusContractDeployment is a user contract deployment proposal, then the release of an approved one
+// deploying the contract.
+func corpusContractDeployment() *aelf.Block {
+	miner, consensusContract, genesisContract := testAddress(0x01), testAddress(0x02), testAddress(0x05)
+	tokenContract, _ := testSystemContracts()
+	author, deployed := testAddress(0x10), testAddress(0x11)
+	extraData, system := corpusSystemTransactions(miner, consensusContract)
+
+	// ContractDeploymentInput, not part of the generated messages: category and code
+	var deploymentInput []byte
+	deploymentInput = protowire.AppendTag(deploymentInput, 1, protowire.VarintType)
+	deploymentInput = protowire.AppendVarint(deploymentInput, 0)
+	deploymentInput = protowire.AppendTag(deploymentInput, 2, protowire.BytesType)
+	deploymentInput = protowire.AppendBytes(deploymentInput, bytes.Repeat([]byte{0x4d, 0x5a}, 32))
+	deployTx := testTransaction(author, genesisContract, "DeployUserSmartContract", nil)
+	deployTx.Params = deploymentInput
+
+	deploy := testTrace(aelf.ExecutionStatus_EXECUTED, &aelf.LogEvent{Address: genesisContract, Name: "CodeCheckRequired", NonIndexed: deploymentInput})
+	deploy.ReturnValue = mustMarshal(&aelf.Hash{Value: testHash(0x30).Value})
+	deploy.PreTransactions, deploy.PreTraces = oneTransaction(corpusFeeCharging(author, tokenContract, "ELF", 10000000))
+
+	release := testTrace(aelf.ExecutionStatus_EXECUTED, testEvent(genesisContract, "ContractDeployed",
+		[]proto.Message{&aelf.ContractDeployed{Author: author}, &aelf.ContractDeployed{CodeHash: testHash(0x31)}},
+		&aelf.ContractDeployed{Address: deployed, Version: 1, ContractVersion: "1.0.0.0", Deployer: author}))
+	release.StateSet.Writes = map[string][]byte{"ContractInfos/" + deployed.ToBase58(): {0x01}, "SmartContractRegistrations/" + testHash(0x31).ToHex(): {0x02}}
+
+	return testBlock(1004, extraData, system,
+		corpusTransaction(deployTx, deploy),
+		corpusTransaction(testTransaction(miner, genesisContract, "ReleaseApprovedUserSmartContract", &aelf.Hash{Value: testHash(0x30).Value}), release),
+	)
+}
+
+// corpusNextTerm is the extra block of a round starting a new term, with a new miner list.
+func corpusNextTerm() *aelf.Block {
+	miner, consensusContract := testAddress(0x01), testAddress(0x02)
+	round := testNextTermRound()
+	extraData := map[string][]byte{
+		systemTransactionCountExtraDataKey: mustMarshal(&wrapperspb.Int32Value{Value: 1}),
+		consensusExtraDataKey: mustMarshal(&aelf.AElfConsensusHeaderInformation{
+			SenderPubkey: []byte{0x04, 0xaa},
+			Round:        round,
+			Behaviour:    aelf.AElfConsensusBehaviour_NEXT_TERM,
+		}),
+	}
+
+	nextTerm := corpusTransaction(testTransaction(miner, consensusContract, nextTermMethodName, round), testTrace(aelf.ExecutionStatus_EXECUTED,
+		&aelf.LogEvent{Address: consensusContract, Name: "MiningInformationUpdated"},
+		&aelf.LogEvent{Address: consensusContract, Name: "MinerListChanged"},
+	))
+	return testBlock(1005, extraData, nextTerm)
+}
+
+func oneTransaction(tx *aelf.Transaction, trace *aelf.TransactionTrace) ([]*aelf.Transaction, []*aelf.TransactionTrace) {
+	return []*aelf.Transaction{tx}, []*aelf.TransactionTrace{trace}
+}
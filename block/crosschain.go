@@ -0,0 +1,132 @@
+package block
+
+import (
+	"log"
+
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	crossChainExtraDataKey = "CrossChain"
+
+	proposeCrossChainIndexingMethodName = "ProposeCrossChainIndexing"
+	recordCrossChainDataMethodName      = "RecordCrossChainData"
+
+	crossChainTransferredEventName = "CrossChainTransferred"
+	crossChainReceivedEventName    = "CrossChainReceived"
+)
+
+// convertCrossChainInfo returns the cross chain data of the block. The side and parent chain block data are
+// the ones indexed by the CrossChain contract, recorded once an indexing proposal is released: a proposal may
+// still be rejected. Only the calls and events of the CrossChain and MultiToken contracts of the chain count.
+func convertCrossChainInfo(header *aelf.BlockHeader, traces []*pbaelf.TransactionTrace) *pbaelf.CrossChainInfo {
+	info := &pbaelf.CrossChainInfo{}
+
+	if data := header.ExtraData[crossChainExtraDataKey]; len(data) > 0 {
+		var extraData aelf.CrossChainExtraData
+		if err := proto.Unmarshal(data, &extraData); err != nil {
+			log.Printf("Failed to unmarshal cross chain extra data at height %d: %v", header.Height, err)
+		} else {
+			info.TransactionStatusMerkleTreeRoot = extraData.TransactionStatusMerkleTreeRoot.ToHex()
+		}
+	}
+
+	contracts := SystemContractsOf(header.ChainId)
+	for _, trace := range traces {
+		for _, call := range trace.Calls {
+			if call.IsReverted {
+				continue
+			}
+			if contracts.CrossChain != "" && call.To == contracts.CrossChain && call.MethodName == recordCrossChainDataMethodName {
+				appendCrossChainBlockData(info, trace.TransactionId, call)
+			}
+			if contracts.MultiToken == "" {
+				continue
+			}
+			for _, l := range call.Logs {
+				if l.Address == contracts.MultiToken {
+					appendCrossChainEvent(info, call, l)
+				}
+			}
+		}
+	}
+
+	if info.TransactionStatusMerkleTreeRoot == "" && len(info.SideChainBlockData) == 0 && len(info.ParentChainBlockData) == 0 &&
+		len(info.Transfers) == 0 && len(info.Receives) == 0 {
+		return nil
+	}
+	return info
+}
+
+func appendCrossChainBlockData(info *pbaelf.CrossChainInfo, transactionId string, call *pbaelf.Call) {
+	var input aelf.RecordCrossChainDataInput
+	if err := proto.Unmarshal(call.Params, &input); err != nil {
+		log.Printf("Failed to unmarshal %s input of transaction %s: %v", call.MethodName, transactionId, err)
+		return
+	}
+	data := input.ProposedCrossChainData
+
+	for _, sideChainBlock := range data.GetSideChainBlockDataList() {
+		info.SideChainBlockData = append(info.SideChainBlockData, &pbaelf.SideChainBlockData{
+			TransactionId:                   transactionId,
+			ChainId:                         sideChainBlock.ChainId,
+			Height:                          sideChainBlock.Height,
+			BlockHeaderHash:                 sideChainBlock.BlockHeaderHash.ToHex(),
+			TransactionStatusMerkleTreeRoot: sideChainBlock.TransactionStatusMerkleTreeRoot.ToHex(),
+		})
+	}
+	for _, parentChainBlock := range data.GetParentChainBlockDataList() {
+		info.ParentChainBlockData = append(info.ParentChainBlockData, &pbaelf.ParentChainBlockData{
+			TransactionId:                   transactionId,
+			ChainId:                         parentChainBlock.ChainId,
+			Height:                          parentChainBlock.Height,
+			TransactionStatusMerkleTreeRoot: parentChainBlock.TransactionStatusMerkleTreeRoot.ToHex(),
+			CrossChainTransactionStatusMerkleTreeRoot: parentChainBlock.CrossChainExtraData.GetTransactionStatusMerkleTreeRoot().ToHex(),
+			ExtraData: parentChainBlock.ExtraData,
+		})
+	}
+}
+
+func appendCrossChainEvent(info *pbaelf.CrossChainInfo, call *pbaelf.Call, event *pbaelf.LogEvent) {
+	switch event.Name {
+	case crossChainTransferredEventName:
+		var transferred aelf.CrossChainTransferred
+		if err := event.Decode(&transferred); err != nil {
+			log.Printf("Failed to decode %s event of transaction %s: %v", event.Name, call.TransactionId, err)
+			return
+		}
+		info.Transfers = append(info.Transfers, &pbaelf.CrossChainTransfer{
+			TransactionId: call.TransactionId,
+			CallPath:      call.CallPath,
+			From:          transferred.From.ToBase58(),
+			To:            transferred.To.ToBase58(),
+			Symbol:        transferred.Symbol,
+			Amount:        transferred.Amount,
+			Memo:          transferred.Memo,
+			ToChainId:     transferred.ToChainId,
+			IssueChainId:  transferred.IssueChainId,
+		})
+
+	case crossChainReceivedEventName:
+		var received aelf.CrossChainReceived
+		if err := event.Decode(&received); err != nil {
+			log.Printf("Failed to decode %s event of transaction %s: %v", event.Name, call.TransactionId, err)
+			return
+		}
+		info.Receives = append(info.Receives, &pbaelf.CrossChainReceive{
+			TransactionId:         call.TransactionId,
+			CallPath:              call.CallPath,
+			From:                  received.From.ToBase58(),
+			To:                    received.To.ToBase58(),
+			Symbol:                received.Symbol,
+			Amount:                received.Amount,
+			Memo:                  received.Memo,
+			FromChainId:           received.FromChainId,
+			IssueChainId:          received.IssueChainId,
+			ParentChainHeight:     received.ParentChainHeight,
+			TransferTransactionId: received.TransferTransactionId.ToHex(),
+		})
+	}
+}
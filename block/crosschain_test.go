@@ -0,0 +1,153 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	"github.com/test-go/testify/assert"
+	"github.com/test-go/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestConvertBlock_CrossChain(t *testing.T) {
+	tokenContract, crossChainContract := testSystemContracts()
+	parliamentContract := testAddress(0x01)
+	otherContract := testAddress(0x02)
+	sender := testAddress(0x03)
+	receiver := testAddress(0x04)
+
+	indexedData := &aelf.CrossChainBlockData{
+		SideChainBlockDataList: []*aelf.SideChainBlockData{
+			{Height: 42, ChainId: 1866392, BlockHeaderHash: testHash(0x06), TransactionStatusMerkleTreeRoot: testHash(0x07)},
+		},
+	}
+	proposedData := &aelf.CrossChainBlockData{
+		SideChainBlockDataList: []*aelf.SideChainBlockData{{Height: 43, ChainId: 1866392}},
+	}
+
+	// The release records, through the parliament, the cross chain data proposed earlier
+	release := testTrace(aelf.ExecutionStatus_EXECUTED)
+	release.InlineTransactions, release.InlineTraces = oneTransaction(
+		testTransaction(crossChainContract, parliamentContract, "Release", nil),
+		testTrace(aelf.ExecutionStatus_EXECUTED))
+	release.InlineTraces[0].InlineTransactions, release.InlineTraces[0].InlineTraces = oneTransaction(
+		testTransaction(parliamentContract, crossChainContract, recordCrossChainDataMethodName, &aelf.RecordCrossChainDataInput{ProposedCrossChainData: indexedData, Proposer: sender}),
+		testTrace(aelf.ExecutionStatus_EXECUTED))
+
+	block := testBlock(100,
+		map[string][]byte{
+			crossChainExtraDataKey: mustMarshal(&aelf.CrossChainExtraData{TransactionStatusMerkleTreeRoot: testHash(0x05)}),
+		},
+		testTransactionWithTrace{
+			id:    testHash(0x10),
+			tx:    testTransaction(sender, crossChainContract, "ReleaseCrossChainIndexingProposal", nil),
+			trace: release,
+		},
+		testTransactionWithTrace{
+			id:    testHash(0x14),
+			tx:    testTransaction(sender, crossChainContract, proposeCrossChainIndexingMethodName, proposedData),
+			trace: testTrace(aelf.ExecutionStatus_EXECUTED),
+		},
+		testTransactionWithTrace{
+			id:    testHash(0x15),
+			tx:    testTransaction(sender, otherContract, recordCrossChainDataMethodName, &aelf.RecordCrossChainDataInput{ProposedCrossChainData: proposedData}),
+			trace: testTrace(aelf.ExecutionStatus_EXECUTED),
+		},
+		testTransactionWithTrace{
+			id: testHash(0x11),
+			tx: testTransaction(sender, tokenContract, "CrossChainTransfer", nil),
+			trace: testTrace(aelf.ExecutionStatus_EXECUTED,
+				testEvent(tokenContract, crossChainTransferredEventName,
+					[]proto.Message{
+						&aelf.CrossChainTransferred{From: sender},
+						&aelf.CrossChainTransferred{To: receiver},
+						&aelf.CrossChainTransferred{Symbol: "ELF"},
+						&aelf.CrossChainTransferred{Amount: 1000},
+					},
+					&aelf.CrossChainTransferred{Memo: "hello", ToChainId: 1866392, IssueChainId: 9992731},
+				),
+				// A same named event of another contract
+				testEvent(otherContract, crossChainTransferredEventName, nil, &aelf.CrossChainTransferred{Amount: 2}),
+			),
+		},
+		testTransactionWithTrace{
+			id: testHash(0x12),
+			tx: testTransaction(sender, tokenContract, "CrossChainReceiveToken", nil),
+			trace: testTrace(aelf.ExecutionStatus_EXECUTED,
+				testEvent(tokenContract, crossChainReceivedEventName,
+					[]proto.Message{&aelf.CrossChainReceived{To: receiver}},
+					&aelf.CrossChainReceived{Symbol: "ELF", Amount: 10, FromChainId: 1866392, TransferTransactionId: testHash(0x08)},
+				),
+			),
+		},
+		testTransactionWithTrace{
+			id: testHash(0x13),
+			tx: testTransaction(sender, tokenContract, "CrossChainTransfer", nil),
+			trace: testTrace(aelf.ExecutionStatus_CONTRACT_ERROR,
+				testEvent(tokenContract, crossChainTransferredEventName, nil, &aelf.CrossChainTransferred{Amount: 1}),
+			),
+		},
+	)
+
+	converted := mustConvertBlock(block)
+
+	info := converted.CrossChain
+	require.NotNil(t, info)
+	assert.Equal(t, testHash(0x05).ToHex(), info.TransactionStatusMerkleTreeRoot)
+
+	require.Len(t, info.SideChainBlockData, 1)
+	assert.Equal(t, testHash(0x10).ToHex(), info.SideChainBlockData[0].TransactionId)
+	assert.Equal(t, int32(1866392), info.SideChainBlockData[0].ChainId)
+	assert.Equal(t, int64(42), info.SideChainBlockData[0].Height)
+	assert.Equal(t, testHash(0x06).ToHex(), info.SideChainBlockData[0].BlockHeaderHash)
+	assert.Empty(t, info.ParentChainBlockData)
+
+	require.Len(t, info.Transfers, 1)
+	transfer := info.Transfers[0]
+	assert.Equal(t, testHash(0x11).ToHex(), transfer.TransactionId)
+	assert.Equal(t, sender.ToBase58(), transfer.From)
+	assert.Equal(t, receiver.ToBase58(), transfer.To)
+	assert.Equal(t, "ELF", transfer.Symbol)
+	assert.Equal(t, int64(1000), transfer.Amount)
+	assert.Equal(t, "hello", transfer.Memo)
+	assert.Equal(t, int32(1866392), transfer.ToChainId)
+
+	require.Len(t, info.Receives, 1)
+	receive := info.Receives[0]
+	assert.Equal(t, "", receive.From)
+	assert.Equal(t, receiver.ToBase58(), receive.To)
+	assert.Equal(t, int64(10), receive.Amount)
+	assert.Equal(t, testHash(0x08).ToHex(), receive.TransferTransactionId)
+}
+
+func TestConvertBlock_NoCrossChain(t *testing.T) {
+	block := testBlock(100, map[string][]byte{crossChainExtraDataKey: {}}, testTransactionWithTrace{
+		id:    testHash(0x10),
+		tx:    testTransaction(testAddress(0x01), testAddress(0x02), "Transfer", nil),
+		trace: testTrace(aelf.ExecutionStatus_EXECUTED),
+	})
+
+	assert.Nil(t, mustConvertBlock(block).CrossChain)
+}
+
+func TestConvertBlock_CrossChainUnknownChain(t *testing.T) {
+	tokenContract, crossChainContract := testSystemContracts()
+	block := testBlock(100, nil,
+		testTransactionWithTrace{
+			id:    testHash(0x10),
+			tx:    testTransaction(testAddress(0x01), crossChainContract, recordCrossChainDataMethodName, &aelf.RecordCrossChainDataInput{ProposedCrossChainData: &aelf.CrossChainBlockData{SideChainBlockDataList: []*aelf.SideChainBlockData{{Height: 42}}}}),
+			trace: testTrace(aelf.ExecutionStatus_EXECUTED, testEvent(tokenContract, crossChainReceivedEventName, nil, &aelf.CrossChainReceived{Amount: 1})),
+		},
+	)
+	block.Header.ChainId = 1
+
+	assert.Nil(t, mustConvertBlock(block).CrossChain, "the system contracts of the chain are unknown")
+
+	RegisterSystemContracts(1, SystemContractsOf(MainChainId))
+	defer RegisterSystemContracts(1, SystemContracts{})
+
+	info := mustConvertBlock(block).CrossChain
+	require.NotNil(t, info)
+	assert.Len(t, info.SideChainBlockData, 1)
+	assert.Len(t, info.Receives, 1)
+}
@@ -0,0 +1,184 @@
+package block
+
+import (
+	"encoding/hex"
+	"strconv"
+	"sync"
+
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+)
+
+var flattenerPool = sync.Pool{
+	New: func() interface{} { return &traceFlattener{} },
+}
+
+// traceFlattener flattens the pre, inline and post trace tree of a transaction into its calls: the calls of
+// the pre traces, the call of the trace itself, then the calls of the inline and post traces.
+//
+// The reverted status and call path of the traces are kept in scratch buffers reused across transactions,
+// and the calls, state sets and logs of a transaction are allocated in one slice each. The base58 encoding of
+// the addresses, costly and mostly of the same few contracts, is cached for the block.
+type traceFlattener struct {
+	addresses map[string]string
+
+	// reverted holds, in visit order, whether each trace of the transaction failed
+	reverted []bool
+	next     int
+	logCount int
+	path     []byte
+
+	txId      string
+	calls     []*pbaelf.Call
+	callSlab  []pbaelf.Call
+	stateSets []pbaelf.TransactionExecutingStateSet
+	logs      []pbaelf.LogEvent
+	logPtrs   []*pbaelf.LogEvent
+}
+
+// reset clears the address cache before the flattening of the transactions of another block.
+func (f *traceFlattener) reset() {
+	if f.addresses == nil {
+		f.addresses = map[string]string{}
+	}
+	clear(f.addresses)
+}
+
+// flatten returns the calls of tx, executed as trace, with the index of the call of tx itself.
+func (f *traceFlattener) flatten(tx *aelf.Transaction, trace *aelf.TransactionTrace, txId string) ([]*pbaelf.Call, int32) {
+	f.reverted, f.next, f.logCount, f.path = f.reverted[:0], 0, 0, f.path[:0]
+	f.markFailed(trace)
+
+	f.txId = txId
+	f.calls = make([]*pbaelf.Call, 0, len(f.reverted))
+	f.callSlab = make([]pbaelf.Call, len(f.reverted))
+	f.stateSets = make([]pbaelf.TransactionExecutingStateSet, len(f.reverted))
+	f.logs = make([]pbaelf.LogEvent, f.logCount)
+	f.logPtrs = make([]*pbaelf.LogEvent, f.logCount)
+
+	mainCallIndex := f.flattenTrace(tx, trace, 0, false)
+
+	calls := f.calls
+	f.calls, f.callSlab, f.stateSets, f.logs, f.logPtrs = nil, nil, nil, nil, nil
+	return calls, int32(mainCallIndex)
+}
+
+// markFailed records whether trace and its descendants failed, in the order flattenTrace visits them. A
+// trace fails when it is not executed or when one of its pre, inline or post traces fails.
+func (f *traceFlattener) markFailed(trace *aelf.TransactionTrace) bool {
+	index := len(f.reverted)
+	f.reverted = append(f.reverted, false)
+	f.logCount += len(trace.GetLogs())
+
+	failed := trace.GetExecutionStatus() != aelf.ExecutionStatus_EXECUTED
+	for _, preTrace := range trace.GetPreTraces() {
+		failed = f.markFailed(preTrace) || failed
+	}
+	for _, inlineTrace := range trace.GetInlineTraces() {
+		failed = f.markFailed(inlineTrace) || failed
+	}
+	for _, postTrace := range trace.GetPostTraces() {
+		failed = f.markFailed(postTrace) || failed
+	}
+
+	f.reverted[index] = failed
+	return failed
+}
+
+// flattenTrace appends the calls of trace, the index-th trace of its parent, and returns the index of its
+// own call. A failed trace is reverted along with its inline traces, its pre and post traces, plugins run
+// before and after it, are only reverted when they failed themselves.
+//
+// The traces and transactions of a trace are matched by index, a trace without transaction gives a call
+// with the transaction fields unset and transactions without trace are left out.
+func (f *traceFlattener) flattenTrace(tx *aelf.Transaction, trace *aelf.TransactionTrace, index int, parentReverted bool) int {
+	reverted := f.reverted[f.next] || parentReverted
+	f.next++
+
+	prefixEnd := len(f.path)
+	f.path = strconv.AppendInt(append(f.path, ':'), int64(index), 10)
+	pathEnd := len(f.path)
+
+	f.path = append(f.path, ":pre"...)
+	for i, preTrace := range trace.GetPreTraces() {
+		f.flattenTrace(transactionAt(trace.GetPreTransactions(), i), preTrace, i, false)
+	}
+	f.path = f.path[:pathEnd]
+
+	mainCallIndex := len(f.calls)
+	stateSet := &f.stateSets[mainCallIndex]
+	*stateSet = pbaelf.TransactionExecutingStateSet{
+		Writes:  trace.GetStateSet().GetWrites(),
+		Reads:   trace.GetStateSet().GetReads(),
+		Deletes: trace.GetStateSet().GetDeletes(),
+	}
+	call := &f.callSlab[mainCallIndex]
+	*call = pbaelf.Call{
+		TransactionId:   f.txId,
+		CallPath:        string(f.path),
+		RefBlockNumber:  tx.GetRefBlockNumber(),
+		RefBlockPrefix:  hex.EncodeToString(tx.GetRefBlockPrefix()),
+		From:            f.base58(tx.GetFrom()),
+		To:              f.base58(tx.GetTo()),
+		MethodName:      tx.GetMethodName(),
+		Params:          tx.GetParams(),
+		ExecutionStatus: pbaelf.ExecutionStatus(trace.GetExecutionStatus()),
+		ReturnValue:     trace.GetReturnValue(),
+		Error:           trace.GetError(),
+		StateSet:        stateSet,
+		Logs:            f.convertLogs(trace.GetLogs()),
+		IsReverted:      reverted,
+	}
+	f.calls = append(f.calls, call)
+
+	for i, inlineTrace := range trace.GetInlineTraces() {
+		f.flattenTrace(transactionAt(trace.GetInlineTransactions(), i), inlineTrace, i, reverted)
+	}
+
+	f.path = append(f.path, ":post"...)
+	for i, postTrace := range trace.GetPostTraces() {
+		f.flattenTrace(transactionAt(trace.GetPostTransactions(), i), postTrace, i, false)
+	}
+	f.path = f.path[:prefixEnd]
+
+	return mainCallIndex
+}
+
+func (f *traceFlattener) convertLogs(original []*aelf.LogEvent) []*pbaelf.LogEvent {
+	if len(original) == 0 {
+		return nil
+	}
+
+	count := len(original)
+	output, values := f.logPtrs[:count:count], f.logs[:count]
+	f.logPtrs, f.logs = f.logPtrs[count:], f.logs[count:]
+	for i, log := range original {
+		values[i] = pbaelf.LogEvent{
+			Address:    f.base58(log.GetAddress()),
+			Name:       log.GetName(),
+			Indexed:    log.GetIndexed(),
+			NonIndexed: log.GetNonIndexed(),
+		}
+		output[i] = &values[i]
+	}
+	return output
+}
+
+func transactionAt(transactions []*aelf.Transaction, index int) *aelf.Transaction {
+	if index < len(transactions) {
+		return transactions[index]
+	}
+	return nil
+}
+
+func (f *traceFlattener) base58(address *aelf.Address) string {
+	if address == nil {
+		return ""
+	}
+	if encoded, found := f.addresses[string(address.Value)]; found {
+		return encoded
+	}
+	encoded := address.ToBase58()
+	f.addresses[string(address.Value)] = encoded
+	return encoded
+}
@@ -0,0 +1,249 @@
+package block
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"github.com/test-go/testify/assert"
+	"github.com/test-go/testify/require"
+)
+
+func TestTraceFlattener(t *testing.T) {
+	sender, contract := testAddress(0x01), testAddress(0x02)
+
+	// The failed inline call reverts the transaction and its own inline call, not the fee charging pre
+	// plugin nor the post plugin
+	inline := testTrace(aelf.ExecutionStatus_CONTRACT_ERROR, &aelf.LogEvent{Address: contract, Name: "Started"})
+	inline.InlineTransactions = []*aelf.Transaction{testTransaction(contract, contract, "Nested", nil)}
+	inline.InlineTraces = []*aelf.TransactionTrace{testTrace(aelf.ExecutionStatus_EXECUTED)}
+
+	trace := testTrace(aelf.ExecutionStatus_EXECUTED, &aelf.LogEvent{Address: contract, Name: "A"}, &aelf.LogEvent{Address: contract, Name: "B"})
+	trace.PreTransactions = []*aelf.Transaction{testTransaction(sender, contract, "ChargeTransactionFees", nil)}
+	trace.PreTraces = []*aelf.TransactionTrace{testTrace(aelf.ExecutionStatus_EXECUTED)}
+	trace.InlineTransactions = []*aelf.Transaction{testTransaction(contract, contract, "Inline", nil)}
+	trace.InlineTraces = []*aelf.TransactionTrace{inline}
+	trace.PostTransactions = []*aelf.Transaction{testTransaction(sender, contract, "ChargeResourceToken", nil)}
+	trace.PostTraces = []*aelf.TransactionTrace{testTrace(aelf.ExecutionStatus_EXECUTED)}
+
+	flattener := &traceFlattener{}
+	flattener.reset()
+	calls, mainCallIndex := flattener.flatten(testTransaction(sender, contract, "Do", nil), trace, "tx")
+
+	type expectedCall struct {
+		path       string
+		method     string
+		isReverted bool
+		logs       []string
+	}
+	expected := []expectedCall{
+		{":0:pre:0", "ChargeTransactionFees", false, nil},
+		{":0", "Do", true, []string{"A", "B"}},
+		{":0:0", "Inline", true, []string{"Started"}},
+		{":0:0:0", "Nested", true, nil},
+		{":0:post:0", "ChargeResourceToken", false, nil},
+	}
+	assert.Equal(t, int32(1), mainCallIndex)
+	require.Len(t, calls, len(expected))
+	for i, call := range calls {
+		var logs []string
+		for _, event := range call.Logs {
+			logs = append(logs, event.Name)
+		}
+		assert.Equal(t, expected[i], expectedCall{call.CallPath, call.MethodName, call.IsReverted, logs}, "call %d", i)
+		assert.Equal(t, "tx", call.TransactionId)
+	}
+
+	// The flattener is reused without altering the calls it returned
+	again, _ := flattener.flatten(testTransaction(sender, contract, "Other", nil), testTrace(aelf.ExecutionStatus_EXECUTED), "other")
+	require.Len(t, again, 1)
+	assert.Equal(t, ":0", again[0].CallPath)
+	assert.Equal(t, "Do", calls[1].MethodName)
+	assert.Equal(t, pbaelf.ExecutionStatus_EXECUTED, calls[1].ExecutionStatus)
+}
+
+// traceTreeGenerator builds trace trees from the bytes of a fuzz input, or random ones: trees up to 5 levels
+// deep of pre, inline and post traces of any execution status, nil traces, and transaction lists shorter or
+// longer than their trace lists.
+type traceTreeGenerator struct {
+	data []byte
+}
+
+var traceTreeStatuses = []aelf.ExecutionStatus{
+	aelf.ExecutionStatus_EXECUTED, aelf.ExecutionStatus_EXECUTED, aelf.ExecutionStatus_EXECUTED,
+	aelf.ExecutionStatus_CONTRACT_ERROR, aelf.ExecutionStatus_PREFAILED, aelf.ExecutionStatus_POSTFAILED,
+	aelf.ExecutionStatus_EXCEEDED_MAX_CALL_DEPTH, aelf.ExecutionStatus_UNDEFINED,
+}
+
+func (g *traceTreeGenerator) next() byte {
+	if len(g.data) == 0 {
+		return 0
+	}
+	b := g.data[0]
+	g.data = g.data[1:]
+	return b
+}
+
+func (g *traceTreeGenerator) trace(depth int) *aelf.TransactionTrace {
+	b := g.next()
+	if b == 0xff {
+		return nil
+	}
+
+	trace := &aelf.TransactionTrace{ExecutionStatus: traceTreeStatuses[int(b)%len(traceTreeStatuses)]}
+	if b&0x08 == 0 {
+		trace.StateSet = &aelf.TransactionExecutingStateSet{Writes: map[string][]byte{"key": {b}}}
+	}
+	for i := 0; i < int(b>>4)%3; i++ {
+		trace.Logs = append(trace.Logs, &aelf.LogEvent{Address: testAddress(b), Name: "Event"})
+	}
+	if depth < 5 {
+		trace.PreTransactions, trace.PreTraces = g.children(depth)
+		trace.InlineTransactions, trace.InlineTraces = g.children(depth)
+		trace.PostTransactions, trace.PostTraces = g.children(depth)
+	}
+	return trace
+}
+
+func (g *traceTreeGenerator) children(depth int) ([]*aelf.Transaction, []*aelf.TransactionTrace) {
+	b := g.next()
+
+	var traces []*aelf.TransactionTrace
+	for i := 0; i < int(b%4); i++ {
+		traces = append(traces, g.trace(depth+1))
+	}
+
+	txCount := len(traces)
+	switch b >> 6 {
+	case 1:
+		txCount--
+	case 2:
+		txCount++
+	}
+	var transactions []*aelf.Transaction
+	for i := 0; i < txCount; i++ {
+		transactions = append(transactions, testTransaction(testAddress(0x01), testAddress(0x02), fmt.Sprintf("Method%d", i), nil))
+	}
+	return transactions, traces
+}
+
+// expectedCall is a trace of a tree with what its flattened call must be, in the order of the calls.
+type expectedCall struct {
+	tx       *aelf.Transaction
+	trace    *aelf.TransactionTrace
+	parent   int
+	isInline bool
+	reverted bool
+}
+
+// expectedCalls walks the tree of trace the simple way: a trace that failed, or that has a failed
+// descendant, is reverted along with its inline descendants.
+func expectedCalls(tx *aelf.Transaction, trace *aelf.TransactionTrace) []*expectedCall {
+	var calls []*expectedCall
+	var visit func(tx *aelf.Transaction, trace *aelf.TransactionTrace, isInline, parentReverted bool) int
+	visit = func(tx *aelf.Transaction, trace *aelf.TransactionTrace, isInline, parentReverted bool) int {
+		reverted := subtreeFailed(trace) || parentReverted
+
+		var children []int
+		for i, preTrace := range trace.GetPreTraces() {
+			children = append(children, visit(transactionAt(trace.GetPreTransactions(), i), preTrace, false, false))
+		}
+		index := len(calls)
+		calls = append(calls, &expectedCall{tx: tx, trace: trace, parent: -1, isInline: isInline, reverted: reverted})
+		for i, inlineTrace := range trace.GetInlineTraces() {
+			children = append(children, visit(transactionAt(trace.GetInlineTransactions(), i), inlineTrace, true, reverted))
+		}
+		for i, postTrace := range trace.GetPostTraces() {
+			children = append(children, visit(transactionAt(trace.GetPostTransactions(), i), postTrace, false, false))
+		}
+
+		for _, child := range children {
+			calls[child].parent = index
+		}
+		return index
+	}
+	visit(tx, trace, false, false)
+	return calls
+}
+
+func subtreeFailed(trace *aelf.TransactionTrace) bool {
+	failed := trace.GetExecutionStatus() != aelf.ExecutionStatus_EXECUTED
+	for _, children := range [][]*aelf.TransactionTrace{trace.GetPreTraces(), trace.GetInlineTraces(), trace.GetPostTraces()} {
+		for _, child := range children {
+			failed = subtreeFailed(child) || failed
+		}
+	}
+	return failed
+}
+
+// checkFlattenedCalls checks the invariants of the calls flattened from the tree of trace.
+func checkFlattenedCalls(t testing.TB, tx *aelf.Transaction, trace *aelf.TransactionTrace, calls []*pbaelf.Call, mainCallIndex int32) {
+	expected := expectedCalls(tx, trace)
+	require.Len(t, calls, len(expected), "one call per trace")
+
+	require.True(t, int(mainCallIndex) < len(calls), "main call index %d out of %d calls", mainCallIndex, len(calls))
+	assert.Equal(t, -1, expected[mainCallIndex].parent, "main call index points at the root trace")
+	assert.Equal(t, ":0", calls[mainCallIndex].CallPath)
+
+	paths := map[string]bool{}
+	for i, call := range calls {
+		assert.False(t, paths[call.CallPath], "call path %s is not unique", call.CallPath)
+		paths[call.CallPath] = true
+
+		assert.Equal(t, expected[i].tx.GetMethodName(), call.MethodName, "call %s", call.CallPath)
+		assert.Equal(t, pbaelf.ExecutionStatus(expected[i].trace.GetExecutionStatus()), call.ExecutionStatus, "call %s", call.CallPath)
+		assert.Equal(t, expected[i].reverted, call.IsReverted, "call %s", call.CallPath)
+
+		if call.ExecutionStatus != pbaelf.ExecutionStatus_EXECUTED {
+			assert.True(t, call.IsReverted, "failed call %s is reverted", call.CallPath)
+		}
+		if parent := expected[i].parent; parent >= 0 {
+			assert.True(t, strings.HasPrefix(call.CallPath, calls[parent].CallPath+":"), "call %s is under %s", call.CallPath, calls[parent].CallPath)
+			if call.IsReverted {
+				assert.True(t, calls[parent].IsReverted, "parent %s of reverted call %s is reverted", calls[parent].CallPath, call.CallPath)
+			}
+			if expected[i].isInline && calls[parent].IsReverted {
+				assert.True(t, call.IsReverted, "inline call %s of reverted %s is reverted", call.CallPath, calls[parent].CallPath)
+			}
+		}
+	}
+}
+
+func TestTraceFlattener_RandomTrees(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	flattener := &traceFlattener{}
+	flattener.reset()
+
+	for i := 0; i < 2000; i++ {
+		data := make([]byte, random.Intn(64))
+		random.Read(data)
+
+		generator := &traceTreeGenerator{data: data}
+		tx, trace := testTransaction(testAddress(0x01), testAddress(0x02), "Root", nil), generator.trace(0)
+		calls, mainCallIndex := flattener.flatten(tx, trace, "tx")
+		checkFlattenedCalls(t, tx, trace, calls, mainCallIndex)
+		if t.Failed() {
+			t.Fatalf("invariants broken by tree %x", data)
+		}
+	}
+}
+
+func FuzzTraceFlattener(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0x00, 0x01, 0x01, 0x00})
+	f.Add([]byte{0x03, 0x43, 0x00, 0x00, 0x00, 0x83, 0x03, 0x00, 0x00, 0x00})
+	f.Add([]byte{0x00, 0x02, 0xff, 0x13, 0x01, 0x02, 0x05, 0x00, 0x00, 0x00})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		generator := &traceTreeGenerator{data: data}
+		tx, trace := testTransaction(testAddress(0x01), testAddress(0x02), "Root", nil), generator.trace(0)
+
+		flattener := &traceFlattener{}
+		flattener.reset()
+		calls, mainCallIndex := flattener.flatten(tx, trace, "tx")
+		checkFlattenedCalls(t, tx, trace, calls, mainCallIndex)
+	})
+}
@@ -0,0 +1,130 @@
+package block
+
+import (
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+func testHash(b byte) *aelf.Hash {
+	value := make([]byte, 32)
+	for i := range value {
+		value[i] = b
+	}
+	return &aelf.Hash{Value: value}
+}
+
+func testAddress(b byte) *aelf.Address {
+	return &aelf.Address{Value: testHash(b).Value}
+}
+
+// testSystemContracts returns the MultiToken and CrossChain contracts of the main chain, the chain of testBlock.
+func testSystemContracts() (tokenContract, crossChainContract *aelf.Address) {
+	contracts := SystemContractsOf(MainChainId)
+	tokenContract, err := aelf.AddressFromBase58(contracts.MultiToken)
+	if err != nil {
+		panic(err)
+	}
+	crossChainContract, err = aelf.AddressFromBase58(contracts.CrossChain)
+	if err != nil {
+		panic(err)
+	}
+	return tokenContract, crossChainContract
+}
+
+func testTransaction(from, to *aelf.Address, methodName string, params proto.Message) *aelf.Transaction {
+	tx := &aelf.Transaction{
+		From:           from,
+		To:             to,
+		RefBlockNumber: 1,
+		RefBlockPrefix: []byte{0x01, 0x02, 0x03, 0x04},
+		MethodName:     methodName,
+		Signature:      []byte{0xff},
+	}
+	if params != nil {
+		data, err := proto.Marshal(params)
+		if err != nil {
+			panic(err)
+		}
+		tx.Params = data
+	}
+	return tx
+}
+
+func testTrace(status aelf.ExecutionStatus, logs ...*aelf.LogEvent) *aelf.TransactionTrace {
+	return &aelf.TransactionTrace{
+		ExecutionStatus: status,
+		Logs:            logs,
+		StateSet:        &aelf.TransactionExecutingStateSet{},
+	}
+}
+
+// testTransactionId returns the id AElf gives to tx.
+func testTransactionId(tx *aelf.Transaction) *aelf.Hash {
+	id, err := TransactionId(mustMarshal(tx))
+	if err != nil {
+		panic(err)
+	}
+	return &aelf.Hash{Value: id}
+}
+
+type testTransactionWithTrace struct {
+	id    *aelf.Hash
+	tx    *aelf.Transaction
+	trace *aelf.TransactionTrace
+}
+
+func testBlock(height int64, extraData map[string][]byte, txs ...testTransactionWithTrace) *aelf.Block {
+	block := &aelf.Block{
+		Header: &aelf.BlockHeader{
+			ChainId:                           9992731,
+			PreviousBlockHash:                 testHash(0xaa),
+			MerkleTreeRootOfTransactions:      testHash(0xbb),
+			MerkleTreeRootOfWorldState:        testHash(0xcc),
+			MerkleTreeRootOfTransactionStatus: testHash(0xdd),
+			Height:                            height,
+			ExtraData:                         extraData,
+			Time:                              timestamppb.New(testTime),
+		},
+		Body:         &aelf.BlockBody{},
+		FirehoseBody: &aelf.FirehoseBlockBody{},
+	}
+	for _, tx := range txs {
+		block.Body.TransactionIds = append(block.Body.TransactionIds, tx.id)
+		block.FirehoseBody.Transactions = append(block.FirehoseBody.Transactions, tx.tx)
+		block.FirehoseBody.TransactionTraces = append(block.FirehoseBody.TransactionTraces, tx.trace)
+	}
+	return block
+}
+
+func mustMarshal(message proto.Message) []byte {
+	data, err := proto.Marshal(message)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+func mustConvertBlock(block *aelf.Block) *pbaelf.Block {
+	converted, err := ConvertBlock("abcd", block)
+	if err != nil {
+		panic(err)
+	}
+	return converted
+}
+
+var testTime = time.Date(2024, 11, 21, 7, 0, 0, 0, time.UTC)
+
+// testEvent builds a log event the way AElf does, each indexed field being serialized in its own message.
+func testEvent(address *aelf.Address, name string, indexed []proto.Message, nonIndexed proto.Message) *aelf.LogEvent {
+	event := &aelf.LogEvent{Address: address, Name: name}
+	for _, message := range indexed {
+		event.Indexed = append(event.Indexed, mustMarshal(message))
+	}
+	if nonIndexed != nil {
+		event.NonIndexed = mustMarshal(nonIndexed)
+	}
+	return event
+}
@@ -0,0 +1,132 @@
+package block
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// Field numbers of `aelf.Block.firehose_body`, `aelf.FirehoseBlockBody.transactions` and
+// `aelf.Transaction.signature`
+const (
+	blockFirehoseBodyField        protowire.Number = 7001
+	firehoseBodyTransactionsField protowire.Number = 1
+	transactionSignatureField     protowire.Number = 10000
+)
+
+// ConvertRawBlock converts the aelf.Block serialized in data, as emitted by the node, to the output schema
+// version. TransactionTrace.raw_transaction holds the original bytes of each transaction, sliced from data,
+// instead of their serialization again. Those not hashing to the id of their transaction are kept, logged and
+// flagged (TransactionTrace.raw_transaction_mismatch).
+func ConvertRawBlock(blockHash string, data []byte, version int32) (*pbaelf.Block, error) {
+	return convertRawBlock(blockHash, data, version, false)
+}
+
+// ConvertRawBlockStrict is ConvertRawBlock failing on original transaction bytes not hashing to the id of
+// their transaction.
+func ConvertRawBlockStrict(blockHash string, data []byte, version int32) (*pbaelf.Block, error) {
+	return convertRawBlock(blockHash, data, version, true)
+}
+
+func convertRawBlock(blockHash string, data []byte, version int32, strict bool) (*pbaelf.Block, error) {
+	var block aelf.Block
+	if err := proto.Unmarshal(data, &block); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal aelf.Block: %w", err)
+	}
+	rawTransactions, err := rawTransactions(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read raw transactions: %w", err)
+	}
+
+	converted, err := convertBlock(blockHash, &block, rawTransactions, strict)
+	if err != nil {
+		return nil, err
+	}
+	if err := MigrateBlock(converted, version); err != nil {
+		return nil, err
+	}
+	return converted, nil
+}
+
+// TransactionId returns the id AElf computes for the serialized transaction rawTransaction, the SHA-256 hash
+// of its serialization without signature, the data the sender signed.
+func TransactionId(rawTransaction []byte) ([]byte, error) {
+	hash := sha256.New()
+	for data := rawTransaction; len(data) > 0; {
+		fieldNumber, wireType, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		valueLength := protowire.ConsumeFieldValue(fieldNumber, wireType, data[n:])
+		if valueLength < 0 {
+			return nil, protowire.ParseError(valueLength)
+		}
+		if fieldNumber != transactionSignatureField {
+			hash.Write(data[:n+valueLength])
+		}
+		data = data[n+valueLength:]
+	}
+	return hash.Sum(nil), nil
+}
+
+// verifyTransactionId checks that rawTransaction hashes to the transaction id txId.
+func verifyTransactionId(rawTransaction []byte, txId *aelf.Hash) error {
+	computed, err := TransactionId(rawTransaction)
+	if err != nil {
+		return fmt.Errorf("unable to read raw transaction: %w", err)
+	}
+	if !bytes.Equal(computed, txId.GetValue()) {
+		return fmt.Errorf("raw transaction hashes to %x instead of its id", computed)
+	}
+	return nil
+}
+
+// rawTransactions returns the bytes of the transactions of the firehose body of the aelf.Block serialized
+// in data, sub-slices of data. Firehose bodies split over several occurrences of the field are merged, as
+// proto.Unmarshal does.
+func rawTransactions(data []byte) ([][]byte, error) {
+	var transactions [][]byte
+	err := rangeBytesFields(data, blockFirehoseBodyField, func(body []byte) error {
+		return rangeBytesFields(body, firehoseBodyTransactionsField, func(transaction []byte) error {
+			transactions = append(transactions, transaction)
+			return nil
+		})
+	})
+	return transactions, err
+}
+
+// rangeBytesFields calls fn with the value of each length delimited field number of the message serialized
+// in data, skipping the other fields.
+func rangeBytesFields(data []byte, number protowire.Number, fn func(value []byte) error) error {
+	for len(data) > 0 {
+		fieldNumber, wireType, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+
+		if fieldNumber == number && wireType == protowire.BytesType {
+			value, n := protowire.ConsumeBytes(data)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			if err := fn(value[:len(value):len(value)]); err != nil {
+				return err
+			}
+			data = data[n:]
+			continue
+		}
+
+		n = protowire.ConsumeFieldValue(fieldNumber, wireType, data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+	}
+	return nil
+}
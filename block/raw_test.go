@@ -0,0 +1,126 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	"github.com/test-go/testify/assert"
+	"github.com/test-go/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestConvertRawBlock(t *testing.T) {
+	sender, contract := testAddress(0x01), testAddress(0x02)
+	tx := testTransaction(sender, contract, "Do", nil)
+
+	// Fields out of order, as proto.Marshal never writes them
+	var rawTx []byte
+	rawTx = protowire.AppendTag(rawTx, 5, protowire.BytesType)
+	rawTx = protowire.AppendString(rawTx, tx.MethodName)
+	rawTx = protowire.AppendTag(rawTx, 1, protowire.BytesType)
+	rawTx = protowire.AppendBytes(rawTx, mustMarshal(tx.From))
+	rawTx = protowire.AppendTag(rawTx, 2, protowire.BytesType)
+	rawTx = protowire.AppendBytes(rawTx, mustMarshal(tx.To))
+	require.NotEqual(t, mustMarshal(&aelf.Transaction{From: sender, To: contract, MethodName: "Do"}), rawTx)
+
+	rawTxId, err := TransactionId(rawTx)
+	require.NoError(t, err)
+	assert.NotEqual(t, testTransactionId(tx).Value, rawTxId)
+
+	block := testBlock(100, nil,
+		testTransactionWithTrace{id: &aelf.Hash{Value: rawTxId}, tx: tx, trace: testTrace(aelf.ExecutionStatus_EXECUTED)},
+		testTransactionWithTrace{id: testTransactionId(tx), tx: tx, trace: testTrace(aelf.ExecutionStatus_EXECUTED)},
+	)
+	firehoseBody := block.FirehoseBody
+	block.FirehoseBody = nil
+	data := mustMarshal(block)
+
+	// The firehose body is split in two occurrences of the field, one transaction each
+	for i := range firehoseBody.Transactions {
+		var body []byte
+		body = protowire.AppendTag(body, firehoseBodyTransactionsField, protowire.BytesType)
+		if i == 0 {
+			body = protowire.AppendBytes(body, rawTx)
+		} else {
+			body = protowire.AppendBytes(body, mustMarshal(tx))
+		}
+		body = append(body, mustMarshal(&aelf.FirehoseBlockBody{TransactionTraces: firehoseBody.TransactionTraces[i : i+1]})...)
+		data = protowire.AppendTag(data, blockFirehoseBodyField, protowire.BytesType)
+		data = protowire.AppendBytes(data, body)
+	}
+
+	converted, err := ConvertRawBlock("abcd", data, LatestVersion)
+	require.NoError(t, err)
+	require.Len(t, converted.TransactionTraces, 2)
+	assert.Equal(t, rawTx, converted.TransactionTraces[0].RawTransaction)
+	assert.Equal(t, mustMarshal(tx), converted.TransactionTraces[1].RawTransaction)
+	assert.Equal(t, "Do", converted.TransactionTraces[0].Calls[0].MethodName)
+
+	_, err = ConvertRawBlock("abcd", data[:len(data)-1], LatestVersion)
+	assert.Error(t, err)
+
+	_, err = ConvertRawBlock("abcd", data, LatestVersion+1)
+	assert.Error(t, err)
+
+	assert.False(t, converted.TransactionTraces[0].RawTransactionMismatch)
+	assert.Equal(t, int32(0), converted.Stats.RawTransactionMismatchCount)
+
+	// Original bytes not matching the transaction id are kept and flagged, unless strict
+	block.Body.TransactionIds[0] = testHash(0xee)
+	block.FirehoseBody = firehoseBody
+	converted, err = ConvertRawBlock("abcd", mustMarshal(block), LatestVersion)
+	require.NoError(t, err)
+	assert.True(t, converted.TransactionTraces[0].RawTransactionMismatch)
+	assert.False(t, converted.TransactionTraces[1].RawTransactionMismatch)
+	assert.Equal(t, mustMarshal(firehoseBody.Transactions[0]), converted.TransactionTraces[0].RawTransaction)
+	assert.Equal(t, int32(1), converted.Stats.RawTransactionMismatchCount)
+
+	_, err = ConvertRawBlockStrict("abcd", mustMarshal(block), LatestVersion)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "instead of its id")
+
+	// Version 1 doesn't have the flag, upgrading derives it again
+	v1, err := ConvertRawBlock("abcd", mustMarshal(block), 1)
+	require.NoError(t, err)
+	assert.False(t, v1.TransactionTraces[0].RawTransactionMismatch)
+	require.NoError(t, MigrateBlock(v1, LatestVersion))
+	assert.True(t, proto.Equal(converted, v1), "upgraded block differs from the converted one")
+}
+
+func TestTransactionId(t *testing.T) {
+	tx := testTransaction(testAddress(0x01), testAddress(0x02), "Do", &aelf.Hash{Value: []byte{0x01}})
+	tx.Signature = []byte{0x01, 0x02}
+	signed, err := TransactionId(mustMarshal(tx))
+	require.NoError(t, err)
+
+	tx.Signature = nil
+	unsigned, err := TransactionId(mustMarshal(tx))
+	require.NoError(t, err)
+	assert.Equal(t, unsigned, signed)
+
+	tx.MethodName = "Other"
+	other, err := TransactionId(mustMarshal(tx))
+	require.NoError(t, err)
+	assert.NotEqual(t, unsigned, other)
+
+	_, err = TransactionId([]byte{0x0a, 0x05})
+	assert.Error(t, err)
+}
+
+func TestRawTransactions_MatchesUnmarshal(t *testing.T) {
+	sender, contract := testAddress(0x01), testAddress(0x02)
+	block := testBlock(100, nil,
+		testTransactionWithTrace{id: testHash(0x10), tx: testTransaction(sender, contract, "A", nil), trace: testTrace(aelf.ExecutionStatus_EXECUTED)},
+		testTransactionWithTrace{id: testHash(0x11), tx: testTransaction(sender, contract, "B", &aelf.Hash{Value: []byte{0x01}}), trace: testTrace(aelf.ExecutionStatus_EXECUTED)},
+	)
+
+	transactions, err := rawTransactions(mustMarshal(block))
+	require.NoError(t, err)
+	require.Len(t, transactions, len(block.FirehoseBody.Transactions))
+	for i, raw := range transactions {
+		var tx aelf.Transaction
+		require.NoError(t, proto.Unmarshal(raw, &tx))
+		assert.True(t, proto.Equal(block.FirehoseBody.Transactions[i], &tx), "transaction %d", i)
+	}
+}
@@ -0,0 +1,76 @@
+package block
+
+import (
+	"log"
+	"strings"
+
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+)
+
+const transactionFeeChargedEventName = "TransactionFeeCharged"
+
+// computeBlockStats returns the statistics of the traces of a block of the chain chainId, the fees being the
+// TransactionFeeCharged events of its MultiToken contract.
+func computeBlockStats(chainId int32, traces []*pbaelf.TransactionTrace) *pbaelf.BlockStats {
+	tokenContract := SystemContractsOf(chainId).MultiToken
+
+	stats := &pbaelf.BlockStats{
+		TransactionCount: int32(len(traces)),
+		FeesBySymbol:     map[string]int64{},
+	}
+
+	for _, trace := range traces {
+		if trace.Kind == pbaelf.TransactionKind_USER {
+			stats.UserTransactionCount++
+		} else {
+			stats.SystemTransactionCount++
+		}
+		if int(trace.MainCallIndex) < len(trace.Calls) && trace.Calls[trace.MainCallIndex].IsReverted {
+			stats.FailedTransactionCount++
+		}
+		stats.TotalElapsed += trace.Elapsed
+		if trace.RawTransactionMismatch {
+			stats.RawTransactionMismatchCount++
+		}
+
+		for _, call := range trace.Calls {
+			stats.CallCount++
+			if depth := CallDepth(call.CallPath); depth > stats.MaxInlineCallDepth {
+				stats.MaxInlineCallDepth = depth
+			}
+			if call.IsReverted {
+				stats.RevertedCallCount++
+				continue
+			}
+			stats.LogCount += int32(len(call.Logs))
+			stats.StateWriteCount += int32(len(call.StateSet.GetWrites()))
+			stats.StateDeleteCount += int32(len(call.StateSet.GetDeletes()))
+
+			for _, event := range call.Logs {
+				if event.Name != transactionFeeChargedEventName || event.Address != tokenContract {
+					continue
+				}
+				var charged aelf.TransactionFeeCharged
+				if err := event.Decode(&charged); err != nil {
+					log.Printf("Failed to decode %s event of transaction %s: %v", event.Name, call.TransactionId, err)
+					continue
+				}
+				stats.FeesBySymbol[charged.Symbol] += charged.Amount
+			}
+		}
+	}
+	return stats
+}
+
+// CallDepth returns the number of call levels below the transaction in callPath, the `pre`/`post` plugin
+// markers not being counted: `:0` is 0, `:0:1` and `:0:pre:0` are 1, `:0:post:1:0` is 2.
+func CallDepth(callPath string) int32 {
+	depth := int32(0)
+	for _, part := range strings.Split(strings.TrimPrefix(callPath, ":"), ":")[1:] {
+		if part != "pre" && part != "post" {
+			depth++
+		}
+	}
+	return depth
+}
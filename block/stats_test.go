@@ -0,0 +1,72 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	"github.com/test-go/testify/assert"
+	"github.com/test-go/testify/require"
+)
+
+func TestConvertBlock_Stats(t *testing.T) {
+	sender := testAddress(0x01)
+	tokenContract, _ := testSystemContracts()
+	contract := testAddress(0x03)
+
+	feeCharged := func(symbol string, amount int64) *aelf.LogEvent {
+		return testEvent(tokenContract, transactionFeeChargedEventName, nil, &aelf.TransactionFeeCharged{Symbol: symbol, Amount: amount})
+	}
+
+	// Successful transaction with a fee charging pre plugin and two levels of inline calls, its contract
+	// emitting an event named as the fee event of the MultiToken contract
+	impostor := testEvent(contract, transactionFeeChargedEventName, nil, &aelf.TransactionFeeCharged{Symbol: "ELF", Amount: 1000})
+	succeeded := testTrace(aelf.ExecutionStatus_EXECUTED, &aelf.LogEvent{Address: contract, Name: "Done"}, impostor)
+	succeeded.Elapsed = 100
+	succeeded.StateSet.Writes = map[string][]byte{"a": {0x01}, "b": {0x02}}
+	succeeded.PreTransactions = []*aelf.Transaction{testTransaction(sender, tokenContract, "ChargeTransactionFees", nil)}
+	succeeded.PreTraces = []*aelf.TransactionTrace{testTrace(aelf.ExecutionStatus_EXECUTED, feeCharged("ELF", 10), feeCharged("USDT", 1))}
+	inline := testTrace(aelf.ExecutionStatus_EXECUTED)
+	inline.StateSet.Deletes = map[string]bool{"c": true}
+	inline.InlineTransactions = []*aelf.Transaction{testTransaction(contract, tokenContract, "Transfer", nil)}
+	inline.InlineTraces = []*aelf.TransactionTrace{testTrace(aelf.ExecutionStatus_EXECUTED)}
+	succeeded.InlineTransactions = []*aelf.Transaction{testTransaction(contract, contract, "Inner", nil)}
+	succeeded.InlineTraces = []*aelf.TransactionTrace{inline}
+
+	// Failed transaction, its fee is still charged but its inline call is reverted
+	failed := testTrace(aelf.ExecutionStatus_CONTRACT_ERROR)
+	failed.Elapsed = 50
+	failed.PreTransactions = []*aelf.Transaction{testTransaction(sender, tokenContract, "ChargeTransactionFees", nil)}
+	failed.PreTraces = []*aelf.TransactionTrace{testTrace(aelf.ExecutionStatus_EXECUTED, feeCharged("ELF", 5))}
+	failed.InlineTransactions = []*aelf.Transaction{testTransaction(contract, tokenContract, "Transfer", nil)}
+	failed.InlineTraces = []*aelf.TransactionTrace{testTrace(aelf.ExecutionStatus_EXECUTED, &aelf.LogEvent{Address: tokenContract, Name: "Transferred"})}
+
+	block := testBlock(100, withSystemTransactionCount(1),
+		testTransactionWithTrace{id: testHash(0x10), tx: testTransaction(sender, contract, "UpdateValue", nil), trace: testTrace(aelf.ExecutionStatus_EXECUTED)},
+		testTransactionWithTrace{id: testHash(0x11), tx: testTransaction(sender, contract, "Do", nil), trace: succeeded},
+		testTransactionWithTrace{id: testHash(0x12), tx: testTransaction(sender, contract, "Do", nil), trace: failed},
+	)
+
+	stats := mustConvertBlock(block).Stats
+	require.NotNil(t, stats)
+	assert.Equal(t, int32(3), stats.TransactionCount)
+	assert.Equal(t, int32(2), stats.UserTransactionCount)
+	assert.Equal(t, int32(1), stats.SystemTransactionCount)
+	assert.Equal(t, int32(1), stats.FailedTransactionCount)
+	// 1 system call, 4 calls for the succeeded transaction, 3 for the failed one of which 2 are reverted
+	assert.Equal(t, int32(8), stats.CallCount)
+	assert.Equal(t, int32(2), stats.RevertedCallCount)
+	assert.Equal(t, int32(2), stats.MaxInlineCallDepth)
+	assert.Equal(t, int32(5), stats.LogCount)
+	assert.Equal(t, int32(2), stats.StateWriteCount)
+	assert.Equal(t, int32(1), stats.StateDeleteCount)
+	assert.Equal(t, int64(150), stats.TotalElapsed)
+	assert.Equal(t, map[string]int64{"ELF": 15, "USDT": 1}, stats.FeesBySymbol)
+}
+
+func TestCallDepth(t *testing.T) {
+	assert.Equal(t, int32(0), CallDepth(":0"))
+	assert.Equal(t, int32(1), CallDepth(":0:1"))
+	assert.Equal(t, int32(2), CallDepth(":0:1:0"))
+	assert.Equal(t, int32(1), CallDepth(":0:pre:0"))
+	assert.Equal(t, int32(2), CallDepth(":0:post:1:0"))
+}
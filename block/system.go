@@ -0,0 +1,70 @@
+package block
+
+import (
+	"bytes"
+	"log"
+
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const systemTransactionCountExtraDataKey = "SystemTransactionCount"
+
+var systemTransactionKinds = map[string]pbaelf.TransactionKind{
+	"UpdateValue":                       pbaelf.TransactionKind_SYSTEM_CONSENSUS,
+	"UpdateTinyBlockInformation":        pbaelf.TransactionKind_SYSTEM_CONSENSUS,
+	nextRoundMethodName:                 pbaelf.TransactionKind_SYSTEM_CONSENSUS,
+	nextTermMethodName:                  pbaelf.TransactionKind_SYSTEM_CONSENSUS,
+	"DonateResourceToken":               pbaelf.TransactionKind_SYSTEM_RESOURCE,
+	"ClaimTransactionFees":              pbaelf.TransactionKind_SYSTEM_RESOURCE,
+	proposeCrossChainIndexingMethodName: pbaelf.TransactionKind_SYSTEM_CROSSCHAIN,
+	"ReleaseCrossChainIndexingProposal": pbaelf.TransactionKind_SYSTEM_CROSSCHAIN,
+	recordCrossChainDataMethodName:      pbaelf.TransactionKind_SYSTEM_CROSSCHAIN,
+}
+
+// transactionClassifier tells system transactions, generated by the miner at the beginning of the block,
+// apart from user transactions.
+type transactionClassifier struct {
+	// systemTransactionCount is the amount of leading system transactions, -1 when the header doesn't record it
+	systemTransactionCount int
+	producer               *aelf.Address
+}
+
+func newTransactionClassifier(header *aelf.BlockHeader) *transactionClassifier {
+	classifier := &transactionClassifier{
+		systemTransactionCount: -1,
+		producer:               aelf.AddressFromPublicKey(header.SignerPubkey),
+	}
+
+	if data, found := header.ExtraData[systemTransactionCountExtraDataKey]; found {
+		var count wrapperspb.Int32Value
+		if err := proto.Unmarshal(data, &count); err != nil {
+			log.Printf("Failed to unmarshal system transaction count extra data at height %d: %v", header.Height, err)
+		} else {
+			classifier.systemTransactionCount = int(count.Value)
+		}
+	}
+	return classifier
+}
+
+func (c *transactionClassifier) classify(index int, tx *aelf.Transaction) pbaelf.TransactionKind {
+	kind, knownMethod := systemTransactionKinds[tx.MethodName]
+
+	if c.systemTransactionCount >= 0 {
+		if index >= c.systemTransactionCount {
+			return pbaelf.TransactionKind_USER
+		}
+		if !knownMethod {
+			return pbaelf.TransactionKind_SYSTEM_OTHER
+		}
+		return kind
+	}
+
+	// Without the count, only transactions signed by the miner calling a known system method are classified as system
+	if knownMethod && bytes.Equal(tx.From.GetValue(), c.producer.Value) {
+		return kind
+	}
+	return pbaelf.TransactionKind_USER
+}
@@ -0,0 +1,45 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"github.com/test-go/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestTransactionClassifier(t *testing.T) {
+	pubkey := []byte{0x04, 0x01, 0x02}
+	producer := aelf.AddressFromPublicKey(pubkey)
+	user := testAddress(0x01)
+	contract := testAddress(0x02)
+
+	tests := []struct {
+		name      string
+		extraData map[string][]byte
+		index     int
+		tx        *aelf.Transaction
+		expected  pbaelf.TransactionKind
+	}{
+		{"count consensus", withSystemTransactionCount(3), 0, testTransaction(producer, contract, "UpdateValue", nil), pbaelf.TransactionKind_SYSTEM_CONSENSUS},
+		{"count resource", withSystemTransactionCount(3), 1, testTransaction(producer, contract, "DonateResourceToken", nil), pbaelf.TransactionKind_SYSTEM_RESOURCE},
+		{"count cross chain", withSystemTransactionCount(3), 2, testTransaction(producer, contract, "ProposeCrossChainIndexing", nil), pbaelf.TransactionKind_SYSTEM_CROSSCHAIN},
+		{"count unknown method", withSystemTransactionCount(3), 2, testTransaction(producer, contract, "Something", nil), pbaelf.TransactionKind_SYSTEM_OTHER},
+		{"count past system", withSystemTransactionCount(3), 3, testTransaction(producer, contract, "UpdateValue", nil), pbaelf.TransactionKind_USER},
+		{"signer consensus", nil, 0, testTransaction(producer, contract, "NextRound", nil), pbaelf.TransactionKind_SYSTEM_CONSENSUS},
+		{"signer unknown method", nil, 0, testTransaction(producer, contract, "Transfer", nil), pbaelf.TransactionKind_USER},
+		{"user known method", nil, 0, testTransaction(user, contract, "DonateResourceToken", nil), pbaelf.TransactionKind_USER},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			classifier := newTransactionClassifier(&aelf.BlockHeader{SignerPubkey: pubkey, ExtraData: test.extraData})
+			assert.Equal(t, test.expected, classifier.classify(test.index, test.tx))
+		})
+	}
+}
+
+func withSystemTransactionCount(count int32) map[string][]byte {
+	return map[string][]byte{systemTransactionCountExtraDataKey: mustMarshal(wrapperspb.Int32(count))}
+}
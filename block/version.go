@@ -0,0 +1,130 @@
+package block
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// LatestVersion is the `Block.version` of the blocks produced by ConvertBlock.
+const LatestVersion int32 = 2
+
+// SchemaVersion describes what an output schema version changed from the previous one. A version only
+// adds fields, blocks of a version are valid blocks of the later ones with the added fields unset.
+type SchemaVersion struct {
+	Version int32
+	Changes string
+
+	// upgrade fills, from the fields of the previous version, the fields the version added
+	upgrade func(block *pbaelf.Block) error
+	// downgrade clears the fields the version added
+	downgrade func(block *pbaelf.Block)
+}
+
+// SchemaVersions is the compatibility table of the output schema versions, indexed by version - 1.
+var SchemaVersions = []*SchemaVersion{
+	{
+		Version: 1,
+		Changes: "Initial schema: header, transaction traces with their flattened calls, logs and state changes",
+	},
+	{
+		Version: 2,
+		Changes: "Adds BlockHeader.consensus, TransactionTrace.kind, TransactionTrace.elapsed, TransactionTrace.raw_transaction_mismatch, " +
+			"Block.stats, Block.cross_chain and Block.consensus_transition. Upgrading a version 1 block derives all of them except TransactionTrace.elapsed " +
+			"(and so BlockStats.total_elapsed), not recorded by version 1.",
+		upgrade:   upgradeToVersion2,
+		downgrade: downgradeFromVersion2,
+	},
+}
+
+// ConvertBlockVersion converts block to the output schema version, LatestVersion being what ConvertBlock
+// produces.
+func ConvertBlockVersion(blockHash string, block *aelf.Block, version int32) (*pbaelf.Block, error) {
+	converted, err := ConvertBlock(blockHash, block)
+	if err != nil {
+		return nil, err
+	}
+	if err := MigrateBlock(converted, version); err != nil {
+		return nil, err
+	}
+	return converted, nil
+}
+
+// MigrateBlock upgrades, or downgrades, block in place to the output schema version. Blocks without
+// version are considered version 1 blocks.
+func MigrateBlock(block *pbaelf.Block, version int32) error {
+	if version < 1 || version > LatestVersion {
+		return fmt.Errorf("unsupported block version %d, supported versions are 1 to %d", version, LatestVersion)
+	}
+
+	current := block.Version
+	if current == 0 {
+		current = 1
+	}
+	if current > LatestVersion {
+		return fmt.Errorf("block #%d has version %d, newer than the latest supported version %d", block.Height, current, LatestVersion)
+	}
+
+	for ; current < version; current++ {
+		if err := SchemaVersions[current].upgrade(block); err != nil {
+			return fmt.Errorf("upgrade block #%d to version %d: %w", block.Height, current+1, err)
+		}
+	}
+	for ; current > version; current-- {
+		SchemaVersions[current-1].downgrade(block)
+	}
+
+	block.Version = version
+	return nil
+}
+
+func upgradeToVersion2(block *pbaelf.Block) error {
+	if block.Header == nil {
+		return fmt.Errorf("block has no header")
+	}
+
+	// Only the header fields used to derive the version 2 fields are needed
+	header := &aelf.BlockHeader{ChainId: block.Header.ChainId, Height: block.Header.Height, ExtraData: block.Header.ExtraData, SignerPubkey: block.Header.SignerPubkey}
+	block.Header.Consensus = convertConsensusInfo(header)
+
+	classifier := newTransactionClassifier(header)
+	for i, trace := range block.TransactionTraces {
+		var tx aelf.Transaction
+		if err := proto.Unmarshal(trace.RawTransaction, &tx); err != nil {
+			return fmt.Errorf("unmarshal transaction %s: %w", trace.TransactionId, err)
+		}
+		trace.Kind = classifier.classify(i, &tx)
+		trace.RawTransactionMismatch = !rawTransactionMatches(trace)
+	}
+
+	block.ConsensusTransition = convertConsensusTransition(block.Header.Consensus, block.TransactionTraces)
+	block.CrossChain = convertCrossChainInfo(header, block.TransactionTraces)
+	block.Stats = computeBlockStats(block.Header.ChainId, block.TransactionTraces)
+	return nil
+}
+
+func downgradeFromVersion2(block *pbaelf.Block) {
+	if block.Header != nil {
+		block.Header.Consensus = nil
+	}
+	for _, trace := range block.TransactionTraces {
+		trace.Kind = pbaelf.TransactionKind_USER
+		trace.Elapsed = 0
+		trace.RawTransactionMismatch = false
+	}
+	block.ConsensusTransition = nil
+	block.CrossChain = nil
+	block.Stats = nil
+}
+
+// rawTransactionMatches reports whether the raw transaction of trace hashes to its id.
+func rawTransactionMatches(trace *pbaelf.TransactionTrace) bool {
+	txId, err := hex.DecodeString(trace.TransactionId)
+	if err != nil {
+		return false
+	}
+	return verifyTransactionId(trace.RawTransaction, &aelf.Hash{Value: txId}) == nil
+}
@@ -0,0 +1,89 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"github.com/test-go/testify/assert"
+	"github.com/test-go/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSchemaVersions(t *testing.T) {
+	require.Len(t, SchemaVersions, int(LatestVersion))
+	for i, version := range SchemaVersions {
+		assert.Equal(t, int32(i+1), version.Version)
+		if i > 0 {
+			assert.NotNil(t, version.upgrade, "version %d", version.Version)
+			assert.NotNil(t, version.downgrade, "version %d", version.Version)
+		}
+	}
+}
+
+func TestMigrateBlock(t *testing.T) {
+	consensusContract := testAddress(0x01)
+	tokenContract, _ := testSystemContracts()
+	round := testNextTermRound()
+	producer := []byte{0x04, 0xaa}
+	sender := testAddress(0x02)
+
+	block := testBlock(100,
+		map[string][]byte{
+			consensusExtraDataKey: mustMarshal(&aelf.AElfConsensusHeaderInformation{
+				SenderPubkey: producer,
+				Round:        round,
+				Behaviour:    aelf.AElfConsensusBehaviour_NEXT_TERM,
+			}),
+		},
+		testTransactionWithTrace{
+			id:    testHash(0x10),
+			tx:    testTransaction(aelf.AddressFromPublicKey(producer), consensusContract, nextTermMethodName, round),
+			trace: testTrace(aelf.ExecutionStatus_EXECUTED, &aelf.LogEvent{Address: consensusContract, Name: "MiningInformationUpdated"}),
+		},
+		testTransactionWithTrace{
+			id: testHash(0x11),
+			tx: testTransaction(sender, tokenContract, "CrossChainTransfer", nil),
+			trace: testTrace(aelf.ExecutionStatus_EXECUTED,
+				testEvent(tokenContract, crossChainTransferredEventName, nil, &aelf.CrossChainTransferred{From: sender, Symbol: "ELF", Amount: 1, ToChainId: TDVVChainId}),
+				testEvent(tokenContract, transactionFeeChargedEventName, nil, &aelf.TransactionFeeCharged{Symbol: "ELF", Amount: 2}),
+			),
+		},
+		testTransactionWithTrace{
+			id: testHash(0x12),
+			tx: testTransaction(sender, tokenContract, "CrossChainReceiveToken", nil),
+			trace: testTrace(aelf.ExecutionStatus_EXECUTED,
+				testEvent(tokenContract, crossChainReceivedEventName, nil, &aelf.CrossChainReceived{To: sender, Symbol: "ELF", Amount: 3, FromChainId: TDVVChainId}),
+			),
+		},
+	)
+	block.Header.SignerPubkey = producer
+
+	latest := mustConvertBlock(block)
+	assert.Equal(t, LatestVersion, latest.Version)
+	assert.Equal(t, pbaelf.TransactionKind_SYSTEM_CONSENSUS, latest.TransactionTraces[0].Kind)
+	require.NotNil(t, latest.CrossChain)
+	assert.Len(t, latest.CrossChain.Transfers, 1)
+	assert.Len(t, latest.CrossChain.Receives, 1)
+	assert.Equal(t, map[string]int64{"ELF": 2}, latest.Stats.FeesBySymbol)
+
+	v1, err := ConvertBlockVersion("abcd", block, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), v1.Version)
+	assert.Nil(t, v1.Header.Consensus)
+	assert.Nil(t, v1.ConsensusTransition)
+	assert.Nil(t, v1.Stats)
+	assert.Nil(t, v1.CrossChain)
+	assert.Equal(t, pbaelf.TransactionKind_USER, v1.TransactionTraces[0].Kind)
+
+	require.NoError(t, MigrateBlock(v1, LatestVersion))
+	assert.True(t, proto.Equal(latest, v1), "upgraded block differs from the converted one")
+
+	v1.Version = 0
+	require.NoError(t, MigrateBlock(v1, 1), "blocks without version are version 1 blocks")
+	assert.Equal(t, int32(1), v1.Version)
+
+	assert.Error(t, MigrateBlock(v1, LatestVersion+1))
+	v1.Version = LatestVersion + 1
+	assert.Error(t, MigrateBlock(v1, 1))
+}
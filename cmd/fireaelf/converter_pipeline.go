@@ -0,0 +1,79 @@
+package main
+
+import (
+	"io"
+	"sync"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+)
+
+// conversionPipeline converts the blocks of the inner console reader of a ReaderWithConverter concurrently,
+// while ReadBlock still returns them in the order they were read.
+//
+// A feeder goroutine reads the blocks from the inner reader and queues, in read order, one result slot
+// per block before handing the block to a conversion goroutine. At most concurrency blocks are converted at
+// the same time and at most bufferSize blocks wait in the queue: when ReadBlock consumers lag behind, the
+// feeder stops reading from the node, which then backs off as it would with a synchronous conversion.
+type conversionPipeline struct {
+	concurrency int
+	bufferSize  int
+
+	startOnce sync.Once
+	results   chan chan conversionResult
+	// err is the inner reader error ending the pipeline, returned once the queued blocks are consumed
+	err error
+}
+
+type conversionResult struct {
+	blk *pbbstream.Block
+	err error
+}
+
+func newConversionPipeline(concurrency, bufferSize int) *conversionPipeline {
+	return &conversionPipeline{
+		concurrency: concurrency,
+		bufferSize:  bufferSize,
+		results:     make(chan chan conversionResult, bufferSize),
+	}
+}
+
+// readBlock returns the next block of r converted, starting the pipeline on first call.
+func (p *conversionPipeline) readBlock(r ReaderWithConverter) (*pbbstream.Block, error) {
+	p.startOnce.Do(func() { go p.feed(r) })
+
+	result, ok := <-p.results
+	if !ok {
+		return nil, p.err
+	}
+	converted := <-result
+	return converted.blk, converted.err
+}
+
+func (p *conversionPipeline) feed(r ReaderWithConverter) {
+	defer close(p.results)
+
+	workers := make(chan struct{}, p.concurrency)
+	for {
+		blk, err := r.inner.ReadBlock()
+		if err != nil {
+			p.err = err
+			return
+		}
+
+		result := make(chan conversionResult, 1)
+		select {
+		case p.results <- result:
+		case <-r.inner.Done():
+			p.err = io.EOF
+			return
+		}
+
+		workers <- struct{}{}
+		go func() {
+			defer func() { <-workers }()
+
+			converted, err := r.archiveAndConvert(blk)
+			result <- conversionResult{blk: converted, err: err}
+		}()
+	}
+}
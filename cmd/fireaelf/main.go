@@ -1,12 +1,16 @@
 package main
 
 import (
-	"errors"
+	"context"
 	"fmt"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
 	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/dstore"
 	"github.com/streamingfast/firehose-aelf/block"
 	"github.com/streamingfast/firehose-aelf/pb/aelf"
 	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"github.com/streamingfast/firehose-aelf/transform"
 	firecore "github.com/streamingfast/firehose-core"
 	fhCmd "github.com/streamingfast/firehose-core/cmd"
 	"github.com/streamingfast/firehose-core/firehose/info"
@@ -15,34 +19,104 @@ import (
 	pbfirehose "github.com/streamingfast/pbgo/sf/firehose/v2"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/anypb"
+	"sort"
+	"strconv"
 	"strings"
 )
 
 type ReaderWithConverter struct {
-	inner       mindreader.ConsolerReader
-	fromTypeUrl string
-	toTypeUrl   string
+	inner mindreader.ConsolerReader
+	// converters are the accepted input payload types, by type name, with their converter
+	converters map[string]inputConverter
+	toTypeUrl  string
+	// version is the output schema version of the converted blocks
+	version int32
+	// strict fails the conversion of blocks holding transactions whose original bytes don't hash to their id,
+	// instead of flagging them (`TransactionTrace.raw_transaction_mismatch`)
+	strict bool
+
+	// rawBlocksStore, when set, receives a one-block file holding the original `aelf.Block` of each
+	// block read, before its conversion
+	rawBlocksStore  dstore.Store
+	rawBlocksSuffix string
+
+	// pipeline, when set, converts blocks concurrently
+	pipeline *conversionPipeline
+}
+
+// inputConverter returns blk with its payload, of the input type the converter is registered for,
+// replaced by a `pbaelf.Block` payload.
+type inputConverter func(r ReaderWithConverter, blk *pbbstream.Block) (*pbbstream.Block, error)
+
+// inputConverters is the registry of the input payload types accepted by ReaderWithConverter.
+var inputConverters = map[string]inputConverter{
+	string(new(aelf.Block).ProtoReflect().Descriptor().FullName()): convertAElfBlock,
+	// Blocks already converted, e.g. when re-reading our own output, are kept untouched
+	string(new(pbaelf.Block).ProtoReflect().Descriptor().FullName()): func(r ReaderWithConverter, blk *pbbstream.Block) (*pbbstream.Block, error) {
+		return blk, nil
+	},
 }
 
 func (r ReaderWithConverter) ReadBlock() (blk *pbbstream.Block, err error) {
+	if r.pipeline != nil {
+		return r.pipeline.readBlock(r)
+	}
 
 	blk, err = r.inner.ReadBlock()
 	if err != nil {
 		return blk, err
 	}
-	if clean(blk.Payload.TypeUrl) != r.fromTypeUrl {
-		return nil, errors.New("unrecognized type to convert from")
+	return r.archiveAndConvert(blk)
+}
+
+// archiveAndConvert writes blk to the raw blocks store, when set, before converting it.
+func (r ReaderWithConverter) archiveAndConvert(blk *pbbstream.Block) (*pbbstream.Block, error) {
+	if r.rawBlocksStore != nil {
+		if err := writeOneBlockFile(context.Background(), r.rawBlocksStore, blk, r.rawBlocksSuffix); err != nil {
+			return nil, fmt.Errorf("write raw block #%d (%s): %w", blk.Number, blk.Id, err)
+		}
+	}
+	return r.convert(blk)
+}
+
+// convert replaces the payload of blk, as produced by the node, by its `pbaelf.Block` conversion using
+// the converter registered for the payload type.
+func (r ReaderWithConverter) convert(blk *pbbstream.Block) (*pbbstream.Block, error) {
+	if blk.Payload == nil {
+		return nil, fmt.Errorf("block #%d (%s) has no payload", blk.Number, blk.Id)
+	}
+	converter, found := r.converters[clean(blk.Payload.TypeUrl)]
+	if !found {
+		return nil, fmt.Errorf("block #%d (%s) has unrecognized payload type %q to convert from, supported types are %s",
+			blk.Number, blk.Id, blk.Payload.TypeUrl, strings.Join(r.inputTypes(), ", "))
 	}
-	var parsed aelf.Block
-	err = proto.Unmarshal(blk.Payload.Value, &parsed)
+	return converter(r, blk)
+}
+
+// inputTypes returns the sorted type names of the accepted input payloads.
+func (r ReaderWithConverter) inputTypes() []string {
+	types := make([]string, 0, len(r.converters))
+	for typeName := range r.converters {
+		types = append(types, typeName)
+	}
+	sort.Strings(types)
+	return types
+}
+
+func convertAElfBlock(r ReaderWithConverter, blk *pbbstream.Block) (*pbbstream.Block, error) {
+	convertRawBlock := block.ConvertRawBlock
+	if r.strict {
+		convertRawBlock = block.ConvertRawBlockStrict
+	}
+	converted, err := convertRawBlock(blk.Id, blk.Payload.Value, r.version)
 	if err != nil {
-		return nil, errors.New("unable to unmarshal aelf.Block")
+		return nil, fmt.Errorf("block #%d (%s): unable to convert %s payload: %w", blk.Number, blk.Id, blk.Payload.TypeUrl, err)
 	}
-	converted := block.ConvertBlock(blk.Id, &parsed)
 	newPayloadBytes, err := proto.Marshal(converted)
 	if err != nil {
-		return nil, errors.New("unable to marshal pbaelf.Block")
+		return nil, fmt.Errorf("block #%d (%s): unable to marshal pbaelf.Block: %w", blk.Number, blk.Id, err)
 	}
 	blk.Payload = &anypb.Any{
 		TypeUrl: r.toTypeUrl,
@@ -66,13 +140,89 @@ func newReaderWithConverter(lines chan string, blockEncoder firecore.BlockEncode
 	if err != nil {
 		return inner, err
 	}
-	fromTypeUrl := new(aelf.Block).ProtoReflect().Descriptor().FullName()
+	reader := newConverter()
+	reader.inner = inner
+	reader.version = viper.GetInt32("reader-node-output-version")
+	reader.strict = viper.GetBool("reader-node-strict-transaction-ids")
+	if reader.version < 1 || reader.version > block.LatestVersion {
+		return nil, fmt.Errorf("invalid reader node output version %d, supported versions are 1 to %d", reader.version, block.LatestVersion)
+	}
+
+	if storeURL := viper.GetString("reader-node-raw-blocks-store-url"); storeURL != "" {
+		reader.rawBlocksStore, err = dstore.NewDBinStore(firecore.MustReplaceDataDir(viper.GetString("global-data-dir"), storeURL))
+		if err != nil {
+			return nil, fmt.Errorf("unable to create raw blocks store at path %q: %w", storeURL, err)
+		}
+		reader.rawBlocksSuffix = viper.GetString("reader-node-one-block-suffix")
+	}
+
+	if err := registerSystemContracts(viper.GetStringSlice("reader-node-system-contracts")); err != nil {
+		return nil, err
+	}
+
+	concurrency, bufferSize := viper.GetInt("reader-node-conversion-concurrency"), viper.GetInt("reader-node-conversion-buffer-size")
+	if concurrency < 1 || bufferSize < 1 {
+		return nil, fmt.Errorf("invalid reader node conversion concurrency %d or buffer size %d, both must be at least 1", concurrency, bufferSize)
+	}
+	if concurrency > 1 {
+		reader.pipeline = newConversionPipeline(concurrency, bufferSize)
+	}
+	return reader, nil
+}
+
+// newConverter returns a ReaderWithConverter without inner reader, only usable to convert blocks.
+func newConverter() *ReaderWithConverter {
 	toTypeUrl := new(pbaelf.Block).ProtoReflect().Descriptor().FullName()
 	return &ReaderWithConverter{
-		inner:       inner,
-		fromTypeUrl: clean(string(fromTypeUrl)),
-		toTypeUrl:   string(toTypeUrl),
-	}, nil
+		converters: inputConverters,
+		toTypeUrl:  string(toTypeUrl),
+		version:    block.LatestVersion,
+	}
+}
+
+func registerReaderFlags(flags *pflag.FlagSet) {
+	flags.Int("reader-node-conversion-concurrency", 1, "Number of blocks converted concurrently by the reader node, blocks are still produced in order, 1 converts each block synchronously as it is read, no gain has been shown over 1 yet, see the README")
+	flags.Int("reader-node-conversion-buffer-size", 16, "Number of blocks read from the node ahead of the last block produced, reading from the node pauses while the buffer is full")
+	flags.Int32("reader-node-output-version", block.LatestVersion, "Output schema version (Block.version) of the converted blocks, consumers can pin an older version during upgrades, see the schema versions table of the README")
+	flags.Bool("reader-node-strict-transaction-ids", false, "Fail on transactions whose original bytes don't hash to their id, so whose signature can't be verified, instead of flagging them (TransactionTrace.raw_transaction_mismatch) and logging them")
+	flags.StringArray("reader-node-system-contracts", nil, "System contracts of a chain, as '<chain_id>:<multi_token_address>:<cross_chain_address>', whose calls and events fill Block.cross_chain, those of the AELF (9992731) and tDVV (1866392) chains are known, can be repeated")
+	flags.String("reader-node-raw-blocks-store-url", "", "When set, the original aelf.Block of each block read from the node is also written, before conversion, as a one-block file to this store (e.g. '{data-dir}/storage/raw-one-blocks'), 'fireaelf tools reconvert-raw-blocks' converts such a store into merged blocks")
+}
+
+// registerSystemContracts registers the system contracts of the `reader-node-system-contracts` flag, or
+// of the `system-contracts` flag of the tools converting blocks.
+func registerSystemContracts(values []string) error {
+	for _, value := range values {
+		chainId, contracts, err := parseSystemContracts(value)
+		if err != nil {
+			return err
+		}
+		block.RegisterSystemContracts(chainId, contracts)
+	}
+	return nil
+}
+
+// parseSystemContracts parses a `<chain_id>:<multi_token_address>:<cross_chain_address>` system contracts
+// flag value.
+func parseSystemContracts(value string) (int32, block.SystemContracts, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) != 3 {
+		return 0, block.SystemContracts{}, fmt.Errorf("invalid system contracts %q, expected <chain_id>:<multi_token_address>:<cross_chain_address>", value)
+	}
+	chainId, err := strconv.ParseInt(parts[0], 10, 32)
+	if err != nil {
+		return 0, block.SystemContracts{}, fmt.Errorf("invalid system contracts %q chain id: %w", value, err)
+	}
+	for _, address := range parts[1:] {
+		if _, err := aelf.AddressFromBase58(address); err != nil {
+			return 0, block.SystemContracts{}, fmt.Errorf("invalid system contracts %q address %q: %w", value, address, err)
+		}
+	}
+	return int32(chainId), block.SystemContracts{MultiToken: parts[1], CrossChain: parts[2]}, nil
+}
+
+func newBlockIndexer(indexStore dstore.Store, indexSize uint64) (firecore.BlockIndexer[*pbaelf.Block], error) {
+	return transform.NewAElfBlockIndexer(indexStore, indexSize), nil
 }
 
 func main() {
@@ -85,8 +235,9 @@ func main() {
 
 		FirstStreamableBlock: 1,
 
-		BlockFactory:         func() firecore.Block { return new(pbaelf.Block) },
-		ConsoleReaderFactory: newReaderWithConverter,
+		BlockFactory:            func() firecore.Block { return new(pbaelf.Block) },
+		ConsoleReaderFactory:    newReaderWithConverter,
+		RegisterExtraStartFlags: registerReaderFlags,
 		InfoResponseFiller: func(firstStreamableBlock *pbbstream.Block, resp *pbfirehose.InfoResponse, validate bool) error {
 			aelfBlock := &pbaelf.Block{}
 			if err := firstStreamableBlock.Payload.UnmarshalTo(aelfBlock); err != nil && validate {
@@ -98,7 +249,18 @@ func main() {
 			return nil
 		},
 
-		Tools: &firecore.ToolsConfig[*pbaelf.Block]{},
+		BlockIndexerFactories: map[string]firecore.BlockIndexerFactory[*pbaelf.Block]{
+			transform.IndexerShortName: newBlockIndexer,
+		},
+
+		BlockTransformerFactories: map[protoreflect.FullName]firecore.BlockTransformerFactory{
+			transform.CallFilterMessageName: transform.CallFilterFactory,
+			transform.HeaderOnlyMessageName: transform.HeaderOnlyFactory,
+		},
+
+		Tools: &firecore.ToolsConfig[*pbaelf.Block]{
+			RegisterExtraCmd: registerExtraTools,
+		},
 	})
 }
 
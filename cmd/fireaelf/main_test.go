@@ -0,0 +1,229 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/firehose-aelf/block"
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	"github.com/test-go/testify/assert"
+	"github.com/test-go/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type testConsoleReader struct {
+	blocks []*pbbstream.Block
+}
+
+func (r *testConsoleReader) ReadBlock() (*pbbstream.Block, error) {
+	if len(r.blocks) == 0 {
+		return nil, io.EOF
+	}
+	blk := r.blocks[0]
+	r.blocks = r.blocks[1:]
+	return blk, nil
+}
+
+func (r *testConsoleReader) Done() <-chan interface{} {
+	return nil
+}
+
+func TestReaderWithConverter(t *testing.T) {
+	id := "0000000000000000000000000000000000000000000000000000000000000010"
+	parentId := "000000000000000000000000000000000000000000000000000000000000000f"
+
+	rawStore, err := dstore.NewDBinStore(t.TempDir())
+	require.NoError(t, err)
+
+	reader := newConverter()
+	reader.rawBlocksStore = rawStore
+	reader.rawBlocksSuffix = "default"
+	reader.inner = &testConsoleReader{blocks: []*pbbstream.Block{newRawTestBlock(t, 16, id, parentId)}}
+
+	blk, err := reader.ReadBlock()
+	require.NoError(t, err)
+	converted, err := decodeBlock(blk)
+	require.NoError(t, err)
+	assert.Equal(t, id, converted.BlockHash)
+	assert.Equal(t, block.LatestVersion, converted.Version)
+
+	var rawTypes []string
+	require.NoError(t, readOneBlockFiles(context.Background(), rawStore, 16, func(raw *pbbstream.Block) error {
+		rawTypes = append(rawTypes, raw.Payload.TypeUrl)
+		return nil
+	}))
+	assert.Equal(t, []string{"type.googleapis.com/aelf.Block"}, rawTypes, "the raw block is archived before conversion")
+
+	// Already converted blocks are accepted untouched
+	value := proto.Clone(blk.Payload).(*anypb.Any).Value
+	again, err := reader.convert(blk)
+	require.NoError(t, err)
+	assert.Equal(t, value, again.Payload.Value)
+
+	unknown, err := anypb.New(wrapperspb.String("block"))
+	require.NoError(t, err)
+	_, err = reader.convert(&pbbstream.Block{Number: 16, Id: id, Payload: unknown})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "#16 ("+id+")")
+	assert.Contains(t, err.Error(), `"type.googleapis.com/google.protobuf.StringValue"`)
+	assert.Contains(t, err.Error(), "aelf.Block, sf.aelf.type.v1.Block")
+
+	_, err = reader.convert(&pbbstream.Block{Number: 16, Id: id, Payload: &anypb.Any{TypeUrl: "type.googleapis.com/aelf.Block", Value: []byte{0xff}}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to convert type.googleapis.com/aelf.Block payload: unable to unmarshal aelf.Block")
+
+	_, err = reader.convert(&pbbstream.Block{Number: 16, Id: id})
+	assert.EqualError(t, err, "block #16 ("+id+") has no payload")
+}
+
+func TestReaderWithConverter_TransactionIdMismatch(t *testing.T) {
+	id := "0000000000000000000000000000000000000000000000000000000000000010"
+	payload, err := anypb.New(&aelf.Block{
+		Header: &aelf.BlockHeader{Height: 16},
+		Body:   &aelf.BlockBody{TransactionIds: []*aelf.Hash{{Value: make([]byte, 32)}}},
+		FirehoseBody: &aelf.FirehoseBlockBody{
+			Transactions:      []*aelf.Transaction{{MethodName: "Do", Signature: []byte{0xff}}},
+			TransactionTraces: []*aelf.TransactionTrace{{ExecutionStatus: aelf.ExecutionStatus_EXECUTED}},
+		},
+	})
+	require.NoError(t, err)
+
+	reader := newConverter()
+	blk, err := reader.convert(&pbbstream.Block{Number: 16, Id: id, Payload: payload})
+	require.NoError(t, err)
+	converted, err := decodeBlock(blk)
+	require.NoError(t, err)
+	require.Len(t, converted.TransactionTraces, 1)
+	assert.True(t, converted.TransactionTraces[0].RawTransactionMismatch)
+	assert.Equal(t, int32(1), converted.Stats.RawTransactionMismatchCount)
+
+	reader.strict = true
+	_, err = reader.convert(&pbbstream.Block{Number: 16, Id: id, Payload: payload})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "instead of its id")
+}
+
+func TestReaderWithConverter_Pipeline(t *testing.T) {
+	blockId := func(num uint64) string { return fmt.Sprintf("%064d", num) }
+
+	var blocks []*pbbstream.Block
+	for num := uint64(1); num <= 50; num++ {
+		blk := newRawTestBlock(t, num, blockId(num), blockId(num-1))
+		if num == 40 {
+			blk.Payload.Value = []byte{0xff}
+		}
+		blocks = append(blocks, blk)
+	}
+
+	reader := newConverter()
+	reader.inner = &testConsoleReader{blocks: blocks}
+	reader.pipeline = newConversionPipeline(4, 2)
+
+	for num := uint64(1); num < 40; num++ {
+		blk, err := reader.ReadBlock()
+		require.NoError(t, err)
+		converted, err := decodeBlock(blk)
+		require.NoError(t, err)
+		assert.Equal(t, blockId(num), converted.BlockHash)
+	}
+
+	_, err := reader.ReadBlock()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "block #40")
+
+	for num := uint64(41); num <= 50; num++ {
+		blk, err := reader.ReadBlock()
+		require.NoError(t, err)
+		assert.Equal(t, num, blk.Number)
+	}
+
+	_, err = reader.ReadBlock()
+	assert.Equal(t, io.EOF, err)
+	_, err = reader.ReadBlock()
+	assert.Equal(t, io.EOF, err)
+}
+
+// repeatingConsoleReader returns count blocks holding, in turn, each of the raw payloads.
+type repeatingConsoleReader struct {
+	payloads []*anypb.Any
+	count    uint64
+	read     uint64
+}
+
+func (r *repeatingConsoleReader) ReadBlock() (*pbbstream.Block, error) {
+	if r.read == r.count {
+		return nil, io.EOF
+	}
+	payload := r.payloads[r.read%uint64(len(r.payloads))]
+	r.read++
+	return &pbbstream.Block{Number: r.read, Id: fmt.Sprintf("%064d", r.read), Payload: payload}, nil
+}
+
+func (r *repeatingConsoleReader) Done() <-chan interface{} {
+	return nil
+}
+
+// BenchmarkReaderWithConverter converts, in turn, the recorded and synthetic fixtures of the block package
+// corpus.
+func BenchmarkReaderWithConverter(b *testing.B) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	var payloads []*anypb.Any
+	err := filepath.WalkDir("../../block/testdata/corpus", func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || filepath.Ext(path) != ".pb" {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		payloads = append(payloads, &anypb.Any{TypeUrl: "type.googleapis.com/aelf.Block", Value: data})
+		return nil
+	})
+	require.NoError(b, err)
+	require.NotEmpty(b, payloads)
+
+	for _, concurrency := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("concurrency-%d", concurrency), func(b *testing.B) {
+			reader := newConverter()
+			reader.inner = &repeatingConsoleReader{payloads: payloads, count: uint64(b.N)}
+			if concurrency > 1 {
+				reader.pipeline = newConversionPipeline(concurrency, 4*concurrency)
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := reader.ReadBlock(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestParseSystemContracts(t *testing.T) {
+	chainId, contracts, err := parseSystemContracts("1931928:ASh2Wt7nSEmYqnGxPPzp4pnVDU4uhj1XW9Se5VeZcX2UDdyjx:2PC7Jhb5V6iZXxz8uQUWvWubYkAoCVhtRGSL7VhTWX85R8DBuN")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1931928), chainId)
+	assert.Equal(t, "ASh2Wt7nSEmYqnGxPPzp4pnVDU4uhj1XW9Se5VeZcX2UDdyjx", contracts.MultiToken)
+	assert.Equal(t, "2PC7Jhb5V6iZXxz8uQUWvWubYkAoCVhtRGSL7VhTWX85R8DBuN", contracts.CrossChain)
+
+	_, _, err = parseSystemContracts("1931928:ASh2Wt7nSEmYqnGxPPzp4pnVDU4uhj1XW9Se5VeZcX2UDdyjx")
+	require.Error(t, err)
+	_, _, err = parseSystemContracts("tDVW:ASh2Wt7nSEmYqnGxPPzp4pnVDU4uhj1XW9Se5VeZcX2UDdyjx:2PC7Jhb5V6iZXxz8uQUWvWubYkAoCVhtRGSL7VhTWX85R8DBuN")
+	require.Error(t, err)
+	_, _, err = parseSystemContracts("1931928:ASh2Wt7nSEmYqnGxPPzp4pnVDU4uhj1XW9Se5VeZcX2UDdyjx:2PC7Jhb5V6iZXxz8uQUWvWubYkAoCVhtRGSL7VhTWX85R8DBuM")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "2PC7Jhb5V6iZXxz8uQUWvWubYkAoCVhtRGSL7VhTWX85R8DBuM")
+}
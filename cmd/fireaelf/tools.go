@@ -0,0 +1,25 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	firecore "github.com/streamingfast/firehose-core"
+	"github.com/streamingfast/logging"
+	"go.uber.org/zap"
+)
+
+func registerExtraTools(chain *firecore.Chain[*pbaelf.Block], toolsCmd *cobra.Command, zlog *zap.Logger, tracer logging.Tracer) error {
+	if err := overrideToolsPrintCmd(toolsCmd); err != nil {
+		return err
+	}
+
+	toolsCmd.AddCommand(newToolsFindTxCmd(zlog))
+	toolsCmd.AddCommand(newToolsAddressHistoryCmd(zlog))
+	toolsCmd.AddCommand(newToolsIndexBlocksCmd(zlog))
+	toolsCmd.AddCommand(newToolsExportJSONCmd(zlog))
+	toolsCmd.AddCommand(newToolsExportParquetCmd(zlog))
+	toolsCmd.AddCommand(newToolsLoadSQLCmd(zlog))
+	toolsCmd.AddCommand(newToolsReconvertRawBlocksCmd(zlog))
+	toolsCmd.AddCommand(newToolsReprocessCmd(zlog))
+	return nil
+}
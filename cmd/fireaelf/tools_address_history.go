@@ -0,0 +1,177 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const (
+	addressRoleFrom       = "from"
+	addressRoleTo         = "to"
+	addressRoleLogAddress = "log_address"
+	addressRoleEventField = "event_field"
+)
+
+type addressHistoryEntry struct {
+	BlockNum      int64           `json:"block_num"`
+	BlockHash     string          `json:"block_hash"`
+	Timestamp     string          `json:"timestamp"`
+	TransactionId string          `json:"transaction_id"`
+	Kind          string          `json:"kind"`
+	Status        string          `json:"status"`
+	From          string          `json:"from"`
+	To            string          `json:"to"`
+	MethodName    string          `json:"method_name"`
+	Matches       []*addressMatch `json:"matches"`
+}
+
+type addressMatch struct {
+	CallPath string `json:"call_path"`
+	Role     string `json:"role"`
+	// Event and Field are only set for the `log_address` and `event_field` roles
+	Event string `json:"event,omitempty"`
+	Field string `json:"field,omitempty"`
+}
+
+func newToolsAddressHistoryCmd(zlog *zap.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "address-history <base58_address>",
+		Short: "Lists, as JSON lines, the transactions of merged blocks involving an address as sender, recipient, log address or indexed event field",
+		Args:  cobra.ExactArgs(1),
+		RunE:  toolsAddressHistoryE(zlog),
+	}
+
+	cmd.Flags().String("store", "./firehose-data/storage/merged-blocks", "URL of the merged blocks store to scan")
+	cmd.Flags().StringP("range", "r", "", "Inclusive block range to scan, e.g. '1000:2000' (open ranges like '1000:' scan until the last merged blocks file)")
+
+	return cmd
+}
+
+func toolsAddressHistoryE(zlog *zap.Logger) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		address, err := aelf.AddressFromBase58(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid address %q: %w", args[0], err)
+		}
+
+		startBlock, stopBlock, err := blockRangeFromFlag(cmd, "range")
+		if err != nil {
+			return err
+		}
+
+		storeURL := sflags.MustGetString(cmd, "store")
+		store, err := dstore.NewDBinStore(storeURL)
+		if err != nil {
+			return fmt.Errorf("unable to create store at path %q: %w", storeURL, err)
+		}
+
+		zlog.Info("scanning merged blocks for address", zap.String("address", address.ToBase58()), zap.Uint64("start_block", startBlock), zap.Uint64("stop_block", stopBlock))
+
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		return walkMergedBlocks(cmd.Context(), store, startBlock, stopBlock, func(blk *pbbstream.Block) error {
+			block, err := decodeBlock(blk)
+			if err != nil {
+				return err
+			}
+
+			for _, trace := range block.TransactionTraces {
+				matches := findAddressMatches(trace, address)
+				if len(matches) == 0 {
+					continue
+				}
+
+				entry := &addressHistoryEntry{
+					BlockNum:      block.Height,
+					BlockHash:     block.BlockHash,
+					Timestamp:     block.Header.Time.AsTime().UTC().Format(time.RFC3339Nano),
+					TransactionId: trace.TransactionId,
+					Kind:          trace.Kind.String(),
+					Status:        transactionStatus(trace),
+					Matches:       matches,
+				}
+				if mainCall := mainCallOf(trace); mainCall != nil {
+					entry.From = mainCall.From
+					entry.To = mainCall.To
+					entry.MethodName = mainCall.MethodName
+				}
+				if err := encoder.Encode(entry); err != nil {
+					return fmt.Errorf("write transaction %s: %w", trace.TransactionId, err)
+				}
+			}
+			return nil
+		})
+	}
+}
+
+// findAddressMatches returns where address appears in the calls of trace, in call order.
+func findAddressMatches(trace *pbaelf.TransactionTrace, address *aelf.Address) []*addressMatch {
+	encoded := address.ToBase58()
+
+	var matches []*addressMatch
+	for _, call := range trace.Calls {
+		if call.From == encoded {
+			matches = append(matches, &addressMatch{CallPath: call.CallPath, Role: addressRoleFrom})
+		}
+		if call.To == encoded {
+			matches = append(matches, &addressMatch{CallPath: call.CallPath, Role: addressRoleTo})
+		}
+
+		for _, event := range call.Logs {
+			if event.Address == encoded {
+				matches = append(matches, &addressMatch{CallPath: call.CallPath, Role: addressRoleLogAddress, Event: event.Name})
+			}
+			for _, indexed := range event.Indexed {
+				if fieldNumber, found := indexedAddressField(indexed, address); found {
+					matches = append(matches, &addressMatch{CallPath: call.CallPath, Role: addressRoleEventField, Event: event.Name, Field: eventFieldName(event.Name, fieldNumber)})
+				}
+			}
+		}
+	}
+	return matches
+}
+
+// indexedAddressField returns the field number of an indexed event part when it holds address. AElf
+// encodes each indexed field as an event message with only that field set, so the part is a single
+// length delimited field whose content is the `Address` message.
+func indexedAddressField(indexed []byte, address *aelf.Address) (protowire.Number, bool) {
+	number, wireType, n := protowire.ConsumeTag(indexed)
+	if n < 0 || wireType != protowire.BytesType {
+		return 0, false
+	}
+	content, m := protowire.ConsumeBytes(indexed[n:])
+	if m < 0 || n+m != len(indexed) {
+		return 0, false
+	}
+
+	innerNumber, innerType, n := protowire.ConsumeTag(content)
+	if n < 0 || innerNumber != 1 || innerType != protowire.BytesType {
+		return 0, false
+	}
+	value, m := protowire.ConsumeBytes(content[n:])
+	if m < 0 || n+m != len(content) {
+		return 0, false
+	}
+	return number, bytes.Equal(value, address.Value)
+}
+
+// eventFieldName returns the JSON name of the field of a known event, the field number otherwise.
+func eventFieldName(eventName string, number protowire.Number) string {
+	if message, found := aelf.NewKnownEvent(eventName); found {
+		if field := message.ProtoReflect().Descriptor().Fields().ByNumber(number); field != nil {
+			return field.JSONName()
+		}
+	}
+	return strconv.Itoa(int(number))
+}
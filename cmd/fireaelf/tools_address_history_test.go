@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/firehose-aelf/block"
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"github.com/test-go/testify/assert"
+	"github.com/test-go/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestFindAddressMatches(t *testing.T) {
+	address := &aelf.Address{Value: bytes.Repeat([]byte{0x01}, 32)}
+	other := &aelf.Address{Value: bytes.Repeat([]byte{0x02}, 32)}
+
+	decoded, err := aelf.AddressFromBase58(address.ToBase58())
+	require.NoError(t, err)
+	assert.Equal(t, address.Value, decoded.Value)
+
+	trace := &pbaelf.TransactionTrace{
+		Calls: []*pbaelf.Call{
+			{CallPath: ":0", From: address.ToBase58(), To: other.ToBase58()},
+			{CallPath: ":0:0", From: other.ToBase58(), To: address.ToBase58(), Logs: []*pbaelf.LogEvent{
+				{Name: "Transferred", Address: address.ToBase58(), Indexed: [][]byte{
+					mustMarshal(t, &aelf.Transferred{From: other}),
+					mustMarshal(t, &aelf.Transferred{To: address}),
+					mustMarshal(t, &aelf.Transferred{Symbol: "ELF"}),
+				}},
+				{Name: "Unknown", Address: other.ToBase58(), Indexed: [][]byte{mustMarshal(t, &aelf.Transferred{From: address})}},
+			}},
+			{CallPath: ":0:1", From: other.ToBase58(), To: other.ToBase58()},
+		},
+	}
+
+	assert.Equal(t, []*addressMatch{
+		{CallPath: ":0", Role: addressRoleFrom},
+		{CallPath: ":0:0", Role: addressRoleTo},
+		{CallPath: ":0:0", Role: addressRoleLogAddress, Event: "Transferred"},
+		{CallPath: ":0:0", Role: addressRoleEventField, Event: "Transferred", Field: "to"},
+		{CallPath: ":0:0", Role: addressRoleEventField, Event: "Unknown", Field: "1"},
+	}, findAddressMatches(trace, address))
+
+	_, err = aelf.AddressFromBase58(address.ToBase58() + "1")
+	assert.Error(t, err)
+}
+
+func TestToolsAddressHistory(t *testing.T) {
+	ctx := context.Background()
+	address := &aelf.Address{Value: bytes.Repeat([]byte{0x01}, 32)}
+	other := &aelf.Address{Value: bytes.Repeat([]byte{0x02}, 32)}
+
+	payload, err := anypb.New(&pbaelf.Block{
+		Version: block.LatestVersion, Height: 7, BlockHash: "aa",
+		Header: &pbaelf.BlockHeader{Time: timestamppb.New(time.Date(2024, 11, 21, 6, 56, 57, 0, time.UTC))},
+		TransactionTraces: []*pbaelf.TransactionTrace{
+			{TransactionId: "tx1", Calls: []*pbaelf.Call{{CallPath: ":0", From: address.ToBase58(), To: other.ToBase58(), MethodName: "Transfer", ExecutionStatus: pbaelf.ExecutionStatus_EXECUTED}}},
+			{TransactionId: "tx2", Calls: []*pbaelf.Call{{CallPath: ":0", From: other.ToBase58(), To: other.ToBase58(), MethodName: "Transfer", ExecutionStatus: pbaelf.ExecutionStatus_EXECUTED}}},
+		},
+	})
+	require.NoError(t, err)
+
+	storeDir := t.TempDir()
+	store, err := dstore.NewDBinStore(storeDir)
+	require.NoError(t, err)
+	require.NoError(t, writeBlocksFile(ctx, store, "0000000000", []*pbbstream.Block{{Number: 7, Id: "aa", ParentNum: 6, ParentId: "bb", Payload: payload}}))
+
+	var out bytes.Buffer
+	cmd := newToolsAddressHistoryCmd(zap.NewNop())
+	cmd.SetArgs([]string{address.ToBase58(), "--store", storeDir, "--range", "0:99"})
+	cmd.SetOut(&out)
+	cmd.SilenceUsage, cmd.SilenceErrors = true, true
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	assert.Equal(t, `{"block_num":7,"block_hash":"aa","timestamp":"2024-11-21T06:56:57Z","transaction_id":"tx1","kind":"USER","status":"EXECUTED",`+
+		`"from":"`+address.ToBase58()+`","to":"`+other.ToBase58()+`","method_name":"Transfer","matches":[{"call_path":":0","role":"from"}]}`+"\n", out.String())
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/bstream"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/dstore"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"github.com/streamingfast/firehose-core/types"
+)
+
+const mergedBlocksBundleSize = 100
+
+func decodeBlock(blk *pbbstream.Block) (*pbaelf.Block, error) {
+	block := new(pbaelf.Block)
+	if err := blk.Payload.UnmarshalTo(block); err != nil {
+		return nil, fmt.Errorf("unmarshal block #%d (%s) payload: %w", blk.Number, blk.Id, err)
+	}
+	return block, nil
+}
+
+// readBlocksFile calls f for each block of the dbin blocks file filename, one-block and merged blocks
+// files share the same format.
+func readBlocksFile(ctx context.Context, store dstore.Store, filename string, f func(blk *pbbstream.Block) error) error {
+	reader, err := store.OpenObject(ctx, filename)
+	if err != nil {
+		return fmt.Errorf("open blocks file %s: %w", filename, err)
+	}
+	defer reader.Close()
+
+	blockReader, err := bstream.NewDBinBlockReader(reader)
+	if err != nil {
+		return fmt.Errorf("new block reader for %s: %w", filename, err)
+	}
+
+	for {
+		blk, err := blockReader.Read()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return fmt.Errorf("read block from %s: %w", filename, err)
+		}
+		if err := f(blk); err != nil {
+			return err
+		}
+	}
+}
+
+// readOneBlockFiles calls f for each one-block file (there is one per fork) of block number blockNum.
+func readOneBlockFiles(ctx context.Context, store dstore.Store, blockNum uint64, f func(blk *pbbstream.Block) error) error {
+	var filenames []string
+	err := store.Walk(ctx, fmt.Sprintf("%010d", blockNum), func(filename string) error {
+		filenames = append(filenames, filename)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("walk one-block files of #%d: %w", blockNum, err)
+	}
+
+	for _, filename := range filenames {
+		if err := readBlocksFile(ctx, store, filename, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeOneBlockFile writes blk as a one-block file of store, named like the reader node ones.
+func writeOneBlockFile(ctx context.Context, store dstore.Store, blk *pbbstream.Block, suffix string) error {
+	return writeBlocksFile(ctx, store, bstream.BlockFileNameWithSuffix(blk, suffix), []*pbbstream.Block{blk})
+}
+
+// writeBlocksFile writes blocks, in order, as the dbin blocks file filename of store.
+func writeBlocksFile(ctx context.Context, store dstore.Store, filename string, blocks []*pbbstream.Block) error {
+	content, err := encodeBlocksFile(blocks)
+	if err != nil {
+		return fmt.Errorf("encode blocks file %s: %w", filename, err)
+	}
+	if err := store.WriteObject(ctx, filename, bytes.NewReader(content)); err != nil {
+		return fmt.Errorf("write blocks file %s: %w", filename, err)
+	}
+	return nil
+}
+
+// encodeBlocksFile returns the dbin blocks file content holding blocks, in order.
+func encodeBlocksFile(blocks []*pbbstream.Block) ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	blockWriter, err := bstream.NewDBinBlockWriter(buffer)
+	if err != nil {
+		return nil, fmt.Errorf("new block writer: %w", err)
+	}
+	for _, blk := range blocks {
+		if err := blockWriter.Write(blk); err != nil {
+			return nil, fmt.Errorf("write block #%d: %w", blk.Number, err)
+		}
+	}
+	return buffer.Bytes(), nil
+}
+
+// walkMergedBlocks calls f for each block of the merged blocks store in range [startBlock, stopBlock),
+// a stopBlock of 0 means until the last merged blocks file. The merged blocks file of startBlock must exist.
+func walkMergedBlocks(ctx context.Context, store dstore.Store, startBlock, stopBlock uint64, f func(blk *pbbstream.Block) error) error {
+	firstBase := startBlock - startBlock%mergedBlocksBundleSize
+	for base := firstBase; stopBlock == 0 || base < stopBlock; base += mergedBlocksBundleSize {
+		filename := fmt.Sprintf("%010d", base)
+		exists, err := store.FileExists(ctx, filename)
+		if err != nil {
+			return fmt.Errorf("check merged blocks file %s: %w", filename, err)
+		}
+		if !exists {
+			if stopBlock == 0 && base != firstBase {
+				return nil
+			}
+			return fmt.Errorf("merged blocks file %s not found in store %s", filename, store.BaseURL())
+		}
+
+		err = readBlocksFile(ctx, store, filename, func(blk *pbbstream.Block) error {
+			if blk.Number < startBlock || (stopBlock != 0 && blk.Number >= stopBlock) {
+				return nil
+			}
+			return f(blk)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// blockRangeFromFlag returns the [startBlock, stopBlock) block range of the inclusive range flag flagName,
+// a stopBlock of 0 means an open range.
+func blockRangeFromFlag(cmd *cobra.Command, flagName string) (startBlock, stopBlock uint64, err error) {
+	blockRange, err := types.GetBlockRangeFromFlagDefault(cmd, flagName, types.NewOpenRange(0))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid %q flag: %w", flagName, err)
+	}
+	if blockRange.Start < 0 {
+		return 0, 0, fmt.Errorf("invalid %q flag: ranges relative to HEAD are not supported", flagName)
+	}
+
+	if blockRange.IsClosed() {
+		stopBlock = *blockRange.Stop + 1
+	}
+	return uint64(blockRange.Start), stopBlock, nil
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	"go.uber.org/zap"
+)
+
+func newToolsExportJSONCmd(zlog *zap.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-json",
+		Short: "Exports merged blocks as JSON lines, one canonical JSON document per block with decoded call params and events",
+		Long: "Exports merged blocks as JSON lines, one document per block following the 'sf.aelf.type.v1.Block' protobuf schema " +
+			"with the protobuf JSON field names, see the 'Exporting blocks to JSON' section of the README for the details.",
+		Args: cobra.NoArgs,
+		RunE: toolsExportJSONE(zlog),
+	}
+
+	cmd.Flags().String("store", "./firehose-data/storage/merged-blocks", "URL of the merged blocks store to export")
+	cmd.Flags().Uint64("start", 0, "First block to export")
+	cmd.Flags().Uint64("stop", 0, "Last block to export (inclusive), 0 exports until the last merged blocks file")
+	cmd.Flags().String("out", "-", "Path of the JSON lines file to write, '-' writes to standard output")
+
+	return cmd
+}
+
+func toolsExportJSONE(zlog *zap.Logger) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		startBlock := sflags.MustGetUint64(cmd, "start")
+		stopBlock := sflags.MustGetUint64(cmd, "stop")
+		if stopBlock != 0 {
+			if stopBlock < startBlock {
+				return fmt.Errorf("stop block %d is lower than start block %d", stopBlock, startBlock)
+			}
+			stopBlock++
+		}
+
+		storeURL := sflags.MustGetString(cmd, "store")
+		store, err := dstore.NewDBinStore(storeURL)
+		if err != nil {
+			return fmt.Errorf("unable to create store at path %q: %w", storeURL, err)
+		}
+
+		var out io.Writer = os.Stdout
+		if path := sflags.MustGetString(cmd, "out"); path != "-" {
+			file, err := os.Create(path)
+			if err != nil {
+				return fmt.Errorf("create output file: %w", err)
+			}
+			defer file.Close()
+			out = file
+		}
+		writer := bufio.NewWriter(out)
+
+		zlog.Info("exporting merged blocks to JSON", zap.Uint64("start_block", startBlock), zap.Uint64("stop_block", stopBlock))
+
+		count := 0
+		err = walkMergedBlocks(cmd.Context(), store, startBlock, stopBlock, func(blk *pbbstream.Block) error {
+			block, err := decodeBlock(blk)
+			if err != nil {
+				return err
+			}
+
+			if _, err := fmt.Fprintln(writer, formatDecodedMessageJSON(block)); err != nil {
+				return fmt.Errorf("write block #%d: %w", blk.Number, err)
+			}
+			count++
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if err := writer.Flush(); err != nil {
+			return fmt.Errorf("flush output: %w", err)
+		}
+
+		zlog.Info("exported merged blocks to JSON", zap.Int("block_count", count))
+		return nil
+	}
+}
@@ -0,0 +1,240 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/firehose-aelf/block"
+	"github.com/xitongsys/parquet-go/writer"
+	"go.uber.org/zap"
+)
+
+func newToolsExportParquetCmd(zlog *zap.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-parquet <output_dir>",
+		Short: "Exports merged blocks as Parquet tables (blocks, transactions, calls, logs, state_writes and token_transfers) partitioned by block range",
+		Long: "Exports merged blocks as Parquet tables, each table being a directory of <output_dir> holding one " +
+			"'<start_block>-<stop_block>.parquet' file per partition of --partition-size blocks, the stop block being exclusive. " +
+			"A partition partially exported, at the bounds of --range, is named after the blocks it holds.",
+		Args: cobra.ExactArgs(1),
+		RunE: toolsExportParquetE(zlog),
+	}
+
+	cmd.Flags().String("store", "./firehose-data/storage/merged-blocks", "URL of the merged blocks store to export")
+	cmd.Flags().StringP("range", "r", "", "Inclusive block range to export, e.g. '0:199999' (open ranges like '100000:' export until the last merged blocks file)")
+	cmd.Flags().Uint64("partition-size", 100000, "Number of blocks of each partition, partitions are aligned on it, the files of partitions partially exported are named after the blocks they hold")
+	cmd.Flags().String("token-contract", "", "Address of the MultiToken contract whose events fill the token_transfers table, same named events of other contracts are ignored, by default the MultiToken contract of the chain of each block, known for the AELF and tDVV chains")
+
+	return cmd
+}
+
+func toolsExportParquetE(zlog *zap.Logger) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		partitionSize := sflags.MustGetUint64(cmd, "partition-size")
+		if partitionSize == 0 {
+			return fmt.Errorf("invalid partition size 0")
+		}
+
+		startBlock, stopBlock, err := blockRangeFromFlag(cmd, "range")
+		if err != nil {
+			return err
+		}
+
+		tokenContract := sflags.MustGetString(cmd, "token-contract")
+
+		storeURL := sflags.MustGetString(cmd, "store")
+		store, err := dstore.NewDBinStore(storeURL)
+		if err != nil {
+			return fmt.Errorf("unable to create store at path %q: %w", storeURL, err)
+		}
+
+		zlog.Info("exporting merged blocks to parquet", zap.String("output_dir", args[0]), zap.Uint64("start_block", startBlock), zap.Uint64("stop_block", stopBlock), zap.Uint64("partition_size", partitionSize))
+
+		exporter := newParquetExporter(args[0], partitionSize, startBlock, stopBlock)
+		err = walkMergedBlocks(cmd.Context(), store, startBlock, stopBlock, func(blk *pbbstream.Block) error {
+			converted, err := decodeBlock(blk)
+			if err != nil {
+				return err
+			}
+
+			contract := tokenContract
+			if contract == "" {
+				contract = block.SystemContractsOf(converted.Header.ChainId).MultiToken
+				if contract == "" {
+					return fmt.Errorf("block #%d is of chain %d whose MultiToken contract is unknown, set it with --token-contract", blk.Number, converted.Header.ChainId)
+				}
+			}
+			return exporter.write(blk.Number, newBlockRows(converted, contract))
+		})
+		if err != nil {
+			exporter.abort()
+			return err
+		}
+		return exporter.close()
+	}
+}
+
+var parquetTables = []struct {
+	name   string
+	schema interface{}
+	rows   func(rows *blockRows) []interface{}
+}{
+	{"blocks", new(blockRow), func(rows *blockRows) []interface{} { return []interface{}{rows.block} }},
+	{"transactions", new(transactionRow), func(rows *blockRows) []interface{} { return toInterfaces(rows.transactions) }},
+	{"calls", new(callRow), func(rows *blockRows) []interface{} { return toInterfaces(rows.calls) }},
+	{"logs", new(logRow), func(rows *blockRows) []interface{} { return toInterfaces(rows.logs) }},
+	{"state_writes", new(stateWriteRow), func(rows *blockRows) []interface{} { return toInterfaces(rows.stateWrites) }},
+	{"token_transfers", new(tokenTransferRow), func(rows *blockRows) []interface{} { return toInterfaces(rows.tokenTransfers) }},
+}
+
+func toInterfaces[T any](rows []T) []interface{} {
+	out := make([]interface{}, len(rows))
+	for i, row := range rows {
+		out[i] = row
+	}
+	return out
+}
+
+// parquetExporter writes the rows of each table to the file of the partition of their block. The files are
+// written under a temporary name and renamed, once the partition is completed, after the blocks they hold:
+// the partition bounds clipped to the exported range, a partial partition never replaces a complete one. On
+// error, the files of the partition being written are removed.
+type parquetExporter struct {
+	outputDir     string
+	partitionSize uint64
+	// startBlock and stopBlock, exclusive and 0 for open ranges, are the exported range
+	startBlock uint64
+	stopBlock  uint64
+
+	partitionStart uint64
+	lastBlock      uint64
+	files          []*os.File
+	writers        []*writer.ParquetWriter
+}
+
+func newParquetExporter(outputDir string, partitionSize, startBlock, stopBlock uint64) *parquetExporter {
+	return &parquetExporter{outputDir: outputDir, partitionSize: partitionSize, startBlock: startBlock, stopBlock: stopBlock}
+}
+
+func (e *parquetExporter) write(blockNum uint64, rows *blockRows) error {
+	partitionStart := blockNum - blockNum%e.partitionSize
+	if e.writers == nil || partitionStart != e.partitionStart {
+		if err := e.complete(true); err != nil {
+			return err
+		}
+		if err := e.open(partitionStart); err != nil {
+			return err
+		}
+	}
+	e.lastBlock = blockNum
+
+	for i, table := range parquetTables {
+		for _, row := range table.rows(rows) {
+			if err := e.writers[i].Write(row); err != nil {
+				return fmt.Errorf("write %s row of block #%d: %w", table.name, blockNum, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (e *parquetExporter) open(partitionStart uint64) error {
+	e.partitionStart = partitionStart
+	filename := fmt.Sprintf("%010d.parquet.tmp", partitionStart)
+
+	for _, table := range parquetTables {
+		dir := filepath.Join(e.outputDir, table.name)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("create %s table directory: %w", table.name, err)
+		}
+
+		file, err := os.Create(filepath.Join(dir, filename))
+		if err != nil {
+			return fmt.Errorf("create %s table file: %w", table.name, err)
+		}
+		e.files = append(e.files, file)
+
+		parquetWriter, err := writer.NewParquetWriterFromWriter(file, table.schema, 1)
+		if err != nil {
+			return fmt.Errorf("create %s table writer: %w", table.name, err)
+		}
+		e.writers = append(e.writers, parquetWriter)
+	}
+	return nil
+}
+
+// close completes the files of the last partition, its blocks up to the last one written when the range is
+// open.
+func (e *parquetExporter) close() error {
+	return e.complete(false)
+}
+
+// complete finalizes the files of the current partition and renames them after the blocks they hold, the
+// whole partition when the next one was reached.
+func (e *parquetExporter) complete(nextPartitionReached bool) error {
+	if e.writers == nil {
+		return nil
+	}
+
+	var firstErr error
+	for i, parquetWriter := range e.writers {
+		if err := parquetWriter.WriteStop(); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("complete %s table file: %w", parquetTables[i].name, err)
+		}
+	}
+	if err := e.closeFiles(); err != nil && firstErr == nil {
+		firstErr = err
+	}
+	if firstErr != nil {
+		e.removeFiles()
+		return firstErr
+	}
+
+	start, stop := max(e.partitionStart, e.startBlock), e.partitionStart+e.partitionSize
+	if !nextPartitionReached {
+		if e.stopBlock != 0 {
+			stop = min(stop, e.stopBlock)
+		} else {
+			stop = e.lastBlock + 1
+		}
+	}
+	filename := fmt.Sprintf("%010d-%010d.parquet", start, stop)
+	for _, file := range e.files {
+		if err := os.Rename(file.Name(), filepath.Join(filepath.Dir(file.Name()), filename)); err != nil {
+			e.removeFiles()
+			return fmt.Errorf("rename table file: %w", err)
+		}
+	}
+
+	e.files, e.writers = nil, nil
+	return nil
+}
+
+// abort removes the files of the partition being written.
+func (e *parquetExporter) abort() {
+	e.closeFiles()
+	e.removeFiles()
+}
+
+func (e *parquetExporter) closeFiles() error {
+	var firstErr error
+	for _, file := range e.files {
+		if err := file.Close(); err != nil && !errors.Is(err, os.ErrClosed) && firstErr == nil {
+			firstErr = fmt.Errorf("close table file: %w", err)
+		}
+	}
+	return firstErr
+}
+
+func (e *parquetExporter) removeFiles() {
+	for _, file := range e.files {
+		os.Remove(file.Name())
+	}
+	e.files, e.writers = nil, nil
+}
@@ -0,0 +1,282 @@
+package main
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/streamingfast/firehose-aelf/block"
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+)
+
+// The Parquet table rows, derived from the `sf.aelf.type.v1` messages. Addresses are base58 encoded, hashes
+// and bytes hex encoded and timestamps stored as microseconds since epoch.
+
+type blockRow struct {
+	BlockNum               int64  `parquet:"name=block_num, type=INT64"`
+	BlockHash              string `parquet:"name=block_hash, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ParentHash             string `parquet:"name=parent_hash, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Timestamp              int64  `parquet:"name=timestamp, type=INT64, convertedtype=TIMESTAMP_MICROS"`
+	ChainId                int32  `parquet:"name=chain_id, type=INT32"`
+	Producer               string `parquet:"name=producer, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	SignerPubkey           string `parquet:"name=signer_pubkey, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	TransactionsRoot       string `parquet:"name=merkle_tree_root_of_transactions, type=BYTE_ARRAY, convertedtype=UTF8"`
+	WorldStateRoot         string `parquet:"name=merkle_tree_root_of_world_state, type=BYTE_ARRAY, convertedtype=UTF8"`
+	TransactionStatusRoot  string `parquet:"name=merkle_tree_root_of_transaction_status, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ConsensusBehaviour     string `parquet:"name=consensus_behaviour, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	RoundNumber            int64  `parquet:"name=round_number, type=INT64"`
+	TermNumber             int64  `parquet:"name=term_number, type=INT64"`
+	TransactionCount       int32  `parquet:"name=transaction_count, type=INT32"`
+	UserTransactionCount   int32  `parquet:"name=user_transaction_count, type=INT32"`
+	SystemTransactionCount int32  `parquet:"name=system_transaction_count, type=INT32"`
+	FailedTransactionCount int32  `parquet:"name=failed_transaction_count, type=INT32"`
+	CallCount              int32  `parquet:"name=call_count, type=INT32"`
+	LogCount               int32  `parquet:"name=log_count, type=INT32"`
+	StateWriteCount        int32  `parquet:"name=state_write_count, type=INT32"`
+	StateDeleteCount       int32  `parquet:"name=state_delete_count, type=INT32"`
+	TotalElapsed           int64  `parquet:"name=total_elapsed, type=INT64"`
+}
+
+type transactionRow struct {
+	BlockNum       int64  `parquet:"name=block_num, type=INT64"`
+	Timestamp      int64  `parquet:"name=timestamp, type=INT64, convertedtype=TIMESTAMP_MICROS"`
+	TransactionId  string `parquet:"name=transaction_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Index          int32  `parquet:"name=index, type=INT32"`
+	Kind           string `parquet:"name=kind, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Status         string `parquet:"name=status, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	From           string `parquet:"name=from, type=BYTE_ARRAY, convertedtype=UTF8"`
+	To             string `parquet:"name=to, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	MethodName     string `parquet:"name=method_name, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Params         string `parquet:"name=params, type=BYTE_ARRAY, convertedtype=UTF8"`
+	RefBlockNumber int64  `parquet:"name=ref_block_number, type=INT64"`
+	RefBlockPrefix string `parquet:"name=ref_block_prefix, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Signature      string `parquet:"name=signature, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Error          string `parquet:"name=error, type=BYTE_ARRAY, convertedtype=UTF8"`
+	CallCount      int32  `parquet:"name=call_count, type=INT32"`
+	LogCount       int32  `parquet:"name=log_count, type=INT32"`
+	Elapsed        int64  `parquet:"name=elapsed, type=INT64"`
+}
+
+type callRow struct {
+	BlockNum        int64  `parquet:"name=block_num, type=INT64"`
+	TransactionId   string `parquet:"name=transaction_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	CallPath        string `parquet:"name=call_path, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Depth           int32  `parquet:"name=depth, type=INT32"`
+	From            string `parquet:"name=from, type=BYTE_ARRAY, convertedtype=UTF8"`
+	To              string `parquet:"name=to, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	MethodName      string `parquet:"name=method_name, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Params          string `parquet:"name=params, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ExecutionStatus string `parquet:"name=execution_status, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	IsReverted      bool   `parquet:"name=is_reverted, type=BOOLEAN"`
+	ReturnValue     string `parquet:"name=return_value, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Error           string `parquet:"name=error, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+type logRow struct {
+	BlockNum      int64  `parquet:"name=block_num, type=INT64"`
+	TransactionId string `parquet:"name=transaction_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	CallPath      string `parquet:"name=call_path, type=BYTE_ARRAY, convertedtype=UTF8"`
+	LogIndex      int32  `parquet:"name=log_index, type=INT32"`
+	Address       string `parquet:"name=address, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Name          string `parquet:"name=name, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	// Indexed holds the hex encoded indexed parts separated by commas
+	Indexed    string `parquet:"name=indexed, type=BYTE_ARRAY, convertedtype=UTF8"`
+	NonIndexed string `parquet:"name=non_indexed, type=BYTE_ARRAY, convertedtype=UTF8"`
+	// Decoded is the JSON rendering of known events, empty otherwise
+	Decoded    string `parquet:"name=decoded, type=BYTE_ARRAY, convertedtype=UTF8"`
+	IsReverted bool   `parquet:"name=is_reverted, type=BOOLEAN"`
+}
+
+type stateWriteRow struct {
+	BlockNum      int64  `parquet:"name=block_num, type=INT64"`
+	TransactionId string `parquet:"name=transaction_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	CallPath      string `parquet:"name=call_path, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Key           string `parquet:"name=key, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Value         string `parquet:"name=value, type=BYTE_ARRAY, convertedtype=UTF8"`
+	IsDelete      bool   `parquet:"name=is_delete, type=BOOLEAN"`
+	IsReverted    bool   `parquet:"name=is_reverted, type=BOOLEAN"`
+}
+
+type tokenTransferRow struct {
+	BlockNum      int64  `parquet:"name=block_num, type=INT64"`
+	Timestamp     int64  `parquet:"name=timestamp, type=INT64, convertedtype=TIMESTAMP_MICROS"`
+	TransactionId string `parquet:"name=transaction_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	CallPath      string `parquet:"name=call_path, type=BYTE_ARRAY, convertedtype=UTF8"`
+	LogIndex      int32  `parquet:"name=log_index, type=INT32"`
+	Contract      string `parquet:"name=contract, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Type          string `parquet:"name=type, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	From          string `parquet:"name=from, type=BYTE_ARRAY, convertedtype=UTF8"`
+	To            string `parquet:"name=to, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Symbol        string `parquet:"name=symbol, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Amount        int64  `parquet:"name=amount, type=INT64"`
+	Memo          string `parquet:"name=memo, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+// blockRows holds the rows of each table for one block.
+type blockRows struct {
+	block          *blockRow
+	transactions   []*transactionRow
+	calls          []*callRow
+	logs           []*logRow
+	stateWrites    []*stateWriteRow
+	tokenTransfers []*tokenTransferRow
+}
+
+// newBlockRows returns the rows of blk. The token transfers are the events of the tokenContract MultiToken
+// contract, of every contract when empty.
+func newBlockRows(blk *pbaelf.Block, tokenContract string) *blockRows {
+	header := blk.Header
+	timestamp := header.Time.AsTime().UnixMicro()
+
+	row := &blockRow{
+		BlockNum:              blk.Height,
+		BlockHash:             blk.BlockHash,
+		ParentHash:            header.PreviousBlockHash,
+		Timestamp:             timestamp,
+		ChainId:               header.ChainId,
+		Producer:              aelf.AddressFromPublicKey(header.SignerPubkey).ToBase58(),
+		SignerPubkey:          hex.EncodeToString(header.SignerPubkey),
+		TransactionsRoot:      header.MerkleTreeRootOfTransactions,
+		WorldStateRoot:        header.MerkleTreeRootOfWorldState,
+		TransactionStatusRoot: header.MerkleTreeRootOfTransactionStatus,
+	}
+	if consensus := header.Consensus; consensus != nil {
+		row.ConsensusBehaviour = consensus.Behaviour.String()
+		row.RoundNumber = consensus.RoundNumber
+		row.TermNumber = consensus.TermNumber
+	}
+	if stats := blk.Stats; stats != nil {
+		row.TransactionCount = stats.TransactionCount
+		row.UserTransactionCount = stats.UserTransactionCount
+		row.SystemTransactionCount = stats.SystemTransactionCount
+		row.FailedTransactionCount = stats.FailedTransactionCount
+		row.CallCount = stats.CallCount
+		row.LogCount = stats.LogCount
+		row.StateWriteCount = stats.StateWriteCount
+		row.StateDeleteCount = stats.StateDeleteCount
+		row.TotalElapsed = stats.TotalElapsed
+	}
+
+	rows := &blockRows{block: row}
+	logIndex := int32(0)
+	for i, trace := range blk.TransactionTraces {
+		transaction := &transactionRow{
+			BlockNum:      blk.Height,
+			Timestamp:     timestamp,
+			TransactionId: trace.TransactionId,
+			Index:         int32(i),
+			Kind:          trace.Kind.String(),
+			Status:        transactionStatus(trace),
+			Signature:     hex.EncodeToString(trace.Signature),
+			CallCount:     int32(len(trace.Calls)),
+			Elapsed:       trace.Elapsed,
+		}
+		if mainCall := mainCallOf(trace); mainCall != nil {
+			transaction.From = mainCall.From
+			transaction.To = mainCall.To
+			transaction.MethodName = mainCall.MethodName
+			transaction.Params = hex.EncodeToString(mainCall.Params)
+			transaction.RefBlockNumber = mainCall.RefBlockNumber
+			transaction.RefBlockPrefix = mainCall.RefBlockPrefix
+			transaction.Error = mainCall.Error
+		}
+		rows.transactions = append(rows.transactions, transaction)
+
+		for _, call := range trace.Calls {
+			rows.calls = append(rows.calls, &callRow{
+				BlockNum:        blk.Height,
+				TransactionId:   trace.TransactionId,
+				CallPath:        call.CallPath,
+				Depth:           block.CallDepth(call.CallPath),
+				From:            call.From,
+				To:              call.To,
+				MethodName:      call.MethodName,
+				Params:          hex.EncodeToString(call.Params),
+				ExecutionStatus: call.ExecutionStatus.String(),
+				IsReverted:      call.IsReverted,
+				ReturnValue:     hex.EncodeToString(call.ReturnValue),
+				Error:           call.Error,
+			})
+
+			writes := call.StateSet.GetWrites()
+			for _, key := range sortedKeys(writes) {
+				rows.stateWrites = append(rows.stateWrites, &stateWriteRow{
+					BlockNum: blk.Height, TransactionId: trace.TransactionId, CallPath: call.CallPath,
+					Key: key, Value: hex.EncodeToString(writes[key]), IsReverted: call.IsReverted,
+				})
+			}
+			for _, key := range sortedKeys(call.StateSet.GetDeletes()) {
+				rows.stateWrites = append(rows.stateWrites, &stateWriteRow{
+					BlockNum: blk.Height, TransactionId: trace.TransactionId, CallPath: call.CallPath,
+					Key: key, IsDelete: true, IsReverted: call.IsReverted,
+				})
+			}
+
+			for _, event := range call.Logs {
+				transaction.LogCount++
+				rows.logs = append(rows.logs, newLogRow(blk.Height, trace.TransactionId, call, logIndex, event))
+				if !call.IsReverted && (tokenContract == "" || event.Address == tokenContract) {
+					if transfer := newTokenTransferRow(event); transfer != nil {
+						transfer.BlockNum = blk.Height
+						transfer.Timestamp = timestamp
+						transfer.TransactionId = trace.TransactionId
+						transfer.CallPath = call.CallPath
+						transfer.LogIndex = logIndex
+						rows.tokenTransfers = append(rows.tokenTransfers, transfer)
+					}
+				}
+				logIndex++
+			}
+		}
+	}
+	return rows
+}
+
+func newLogRow(blockNum int64, transactionId string, call *pbaelf.Call, logIndex int32, event *pbaelf.LogEvent) *logRow {
+	indexed := make([]string, len(event.Indexed))
+	for i, data := range event.Indexed {
+		indexed[i] = hex.EncodeToString(data)
+	}
+	decoded, _ := decodeKnownEvent(event)
+
+	return &logRow{
+		BlockNum:      blockNum,
+		TransactionId: transactionId,
+		CallPath:      call.CallPath,
+		LogIndex:      logIndex,
+		Address:       event.Address,
+		Name:          event.Name,
+		Indexed:       strings.Join(indexed, ","),
+		NonIndexed:    hex.EncodeToString(event.NonIndexed),
+		Decoded:       decoded,
+		IsReverted:    call.IsReverted,
+	}
+}
+
+// newTokenTransferRow returns the token balance change of a MultiToken event, nil for other events. The
+// type is the one of the `sf.aelf.substreams.v1.TokenTransfer` Substreams module output.
+func newTokenTransferRow(event *pbaelf.LogEvent) *tokenTransferRow {
+	message, found := decodeKnownEventMessage(event)
+	if !found {
+		return nil
+	}
+
+	row := &tokenTransferRow{Contract: event.Address}
+	switch e := message.(type) {
+	case *aelf.Transferred:
+		row.Type, row.From, row.To, row.Symbol, row.Amount, row.Memo = "TRANSFER", e.From.ToBase58(), e.To.ToBase58(), e.Symbol, e.Amount, e.Memo
+	case *aelf.Issued:
+		row.Type, row.To, row.Symbol, row.Amount, row.Memo = "ISSUE", e.To.ToBase58(), e.Symbol, e.Amount, e.Memo
+	case *aelf.Burned:
+		row.Type, row.From, row.Symbol, row.Amount = "BURN", e.Burner.ToBase58(), e.Symbol, e.Amount
+	case *aelf.TransactionFeeCharged:
+		row.Type, row.From, row.Symbol, row.Amount = "FEE", e.ChargingAddress.ToBase58(), e.Symbol, e.Amount
+	case *aelf.CrossChainTransferred:
+		row.Type, row.From, row.Symbol, row.Amount, row.Memo = "CROSS_CHAIN_TRANSFER", e.From.ToBase58(), e.Symbol, e.Amount, e.Memo
+	case *aelf.CrossChainReceived:
+		row.Type, row.To, row.Symbol, row.Amount, row.Memo = "CROSS_CHAIN_RECEIVE", e.To.ToBase58(), e.Symbol, e.Amount, e.Memo
+	default:
+		return nil
+	}
+	return row
+}
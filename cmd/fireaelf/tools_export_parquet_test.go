@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/firehose-aelf/block"
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"github.com/test-go/testify/assert"
+	"github.com/test-go/testify/require"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/reader"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestParquetExporter(t *testing.T) {
+	from := &aelf.Address{Value: make([]byte, 32)}
+	blockTime := time.Date(2024, 11, 21, 6, 56, 57, 0, time.UTC)
+	newBlock := func(height int64) *pbaelf.Block {
+		return &pbaelf.Block{
+			Height:    height,
+			BlockHash: "hash",
+			Header:    &pbaelf.BlockHeader{Height: height, Time: timestamppb.New(blockTime)},
+			TransactionTraces: []*pbaelf.TransactionTrace{
+				{TransactionId: "tx", Calls: []*pbaelf.Call{
+					{CallPath: ":0:pre:0", To: "token", MethodName: "ChargeTransactionFees", ExecutionStatus: pbaelf.ExecutionStatus_EXECUTED},
+					{CallPath: ":0", To: "token", MethodName: "Transfer", ExecutionStatus: pbaelf.ExecutionStatus_EXECUTED,
+						StateSet: &pbaelf.TransactionExecutingStateSet{Writes: map[string][]byte{"b": {0x02}, "a": {0x01}}, Deletes: map[string]bool{"c": true}},
+						Logs: []*pbaelf.LogEvent{{Address: "token", Name: "Transferred",
+							Indexed:    [][]byte{mustMarshal(t, &aelf.Transferred{From: from}), mustMarshal(t, &aelf.Transferred{Symbol: "ELF"})},
+							NonIndexed: mustMarshal(t, &aelf.Transferred{Amount: 100}),
+						}}},
+				}, MainCallIndex: 1},
+			},
+		}
+	}
+
+	rows := newBlockRows(newBlock(10), "token")
+	require.Len(t, rows.transactions, 1)
+	assert.Equal(t, "Transfer", rows.transactions[0].MethodName)
+	assert.Equal(t, int32(1), rows.transactions[0].LogCount)
+	require.Len(t, rows.calls, 2)
+	assert.Equal(t, int32(1), rows.calls[0].Depth)
+	assert.Equal(t, int32(0), rows.calls[1].Depth)
+	require.Len(t, rows.stateWrites, 3)
+	assert.Equal(t, []string{"a", "b", "c"}, []string{rows.stateWrites[0].Key, rows.stateWrites[1].Key, rows.stateWrites[2].Key})
+	assert.True(t, rows.stateWrites[2].IsDelete)
+	require.Len(t, rows.tokenTransfers, 1)
+	assert.Equal(t, &tokenTransferRow{
+		BlockNum: 10, Timestamp: blockTime.UnixMicro(), TransactionId: "tx", CallPath: ":0", Contract: "token",
+		Type: "TRANSFER", From: from.ToBase58(), Symbol: "ELF", Amount: 100,
+	}, rows.tokenTransfers[0])
+
+	// Same named events of other contracts are not token transfers
+	assert.Empty(t, newBlockRows(newBlock(10), "other").tokenTransfers)
+	assert.Len(t, newBlockRows(newBlock(10), "").tokenTransfers, 1)
+
+	outputDir := t.TempDir()
+	exporter := newParquetExporter(outputDir, 10, 0, 0)
+	for _, height := range []int64{8, 9, 10} {
+		require.NoError(t, exporter.write(uint64(height), newBlockRows(newBlock(height), "token")))
+	}
+	require.NoError(t, exporter.close())
+
+	readCalls := func(filename string) []callRow {
+		file, err := local.NewLocalFileReader(filepath.Join(outputDir, "calls", filename))
+		require.NoError(t, err)
+		defer file.Close()
+
+		parquetReader, err := reader.NewParquetReader(file, new(callRow), 1)
+		require.NoError(t, err)
+		defer parquetReader.ReadStop()
+
+		calls := make([]callRow, parquetReader.GetNumRows())
+		require.NoError(t, parquetReader.Read(&calls))
+		return calls
+	}
+
+	calls := readCalls("0000000000-0000000010.parquet")
+	require.Len(t, calls, 4)
+	assert.Equal(t, callRow{BlockNum: 8, TransactionId: "tx", CallPath: ":0:pre:0", Depth: 1, To: "token", MethodName: "ChargeTransactionFees", ExecutionStatus: "EXECUTED"}, calls[0])
+	assert.Len(t, readCalls("0000000010-0000000011.parquet"), 2, "the open range ends at the last block written")
+
+	// Partitions partially exported are named after the range bounds
+	exporter = newParquetExporter(outputDir, 10, 15, 27)
+	for _, height := range []int64{15, 19, 20, 26} {
+		require.NoError(t, exporter.write(uint64(height), newBlockRows(newBlock(height), "token")))
+	}
+	require.NoError(t, exporter.close())
+	assert.Len(t, readCalls("0000000015-0000000020.parquet"), 4)
+	assert.Len(t, readCalls("0000000020-0000000027.parquet"), 4)
+
+	// The partition being written when the export fails is removed
+	exporter = newParquetExporter(outputDir, 10, 30, 0)
+	require.NoError(t, exporter.write(30, newBlockRows(newBlock(30), "token")))
+	exporter.abort()
+
+	filenames := func(table string) []string {
+		entries, err := os.ReadDir(filepath.Join(outputDir, table))
+		require.NoError(t, err)
+		var names []string
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+		}
+		return names
+	}
+	expected := []string{"0000000000-0000000010.parquet", "0000000010-0000000011.parquet", "0000000015-0000000020.parquet", "0000000020-0000000027.parquet"}
+	for _, table := range parquetTables {
+		assert.Equal(t, expected, filenames(table.name), "table %s", table.name)
+	}
+}
+
+func TestToolsExportParquet_TokenContract(t *testing.T) {
+	ctx := context.Background()
+	from := &aelf.Address{Value: make([]byte, 32)}
+	tdvvToken := block.SystemContractsOf(block.TDVVChainId).MultiToken
+
+	writeStore := func(chainId int32) string {
+		storeDir := t.TempDir()
+		store, err := dstore.NewDBinStore(storeDir)
+		require.NoError(t, err)
+
+		var blocks []*pbbstream.Block
+		for num := uint64(1); num < mergedBlocksBundleSize; num++ {
+			payload, err := anypb.New(&pbaelf.Block{
+				Version: block.LatestVersion, Height: int64(num), BlockHash: fmt.Sprintf("%064d", num),
+				Header: &pbaelf.BlockHeader{ChainId: chainId, Height: int64(num), Time: timestamppb.Now()},
+				TransactionTraces: []*pbaelf.TransactionTrace{{TransactionId: "tx", Calls: []*pbaelf.Call{
+					{CallPath: ":0", To: tdvvToken, MethodName: "Transfer", ExecutionStatus: pbaelf.ExecutionStatus_EXECUTED,
+						Logs: []*pbaelf.LogEvent{{Address: tdvvToken, Name: "Transferred",
+							Indexed:    [][]byte{mustMarshal(t, &aelf.Transferred{From: from}), mustMarshal(t, &aelf.Transferred{Symbol: "ELF"})},
+							NonIndexed: mustMarshal(t, &aelf.Transferred{Amount: 100}),
+						}}},
+				}}},
+			})
+			require.NoError(t, err)
+			blocks = append(blocks, &pbbstream.Block{Number: num, Id: fmt.Sprintf("%064d", num), ParentNum: num - 1, ParentId: fmt.Sprintf("%064d", num-1), Payload: payload})
+		}
+		require.NoError(t, writeBlocksFile(ctx, store, "0000000000", blocks))
+		return storeDir
+	}
+
+	export := func(storeDir string, flags ...string) (string, error) {
+		outputDir := t.TempDir()
+		cmd := newToolsExportParquetCmd(zap.NewNop())
+		cmd.SetArgs(append([]string{outputDir, "--store", storeDir, "--range", "0:9", "--partition-size", "10"}, flags...))
+		cmd.SilenceUsage, cmd.SilenceErrors = true, true
+		return outputDir, cmd.ExecuteContext(ctx)
+	}
+	transferCount := func(outputDir string) int64 {
+		file, err := local.NewLocalFileReader(filepath.Join(outputDir, "token_transfers", "0000000000-0000000010.parquet"))
+		require.NoError(t, err)
+		defer file.Close()
+
+		parquetReader, err := reader.NewParquetReader(file, new(tokenTransferRow), 1)
+		require.NoError(t, err)
+		defer parquetReader.ReadStop()
+		return parquetReader.GetNumRows()
+	}
+
+	// The MultiToken contract is the one of the chain of the blocks by default
+	outputDir, err := export(writeStore(block.TDVVChainId))
+	require.NoError(t, err)
+	assert.Equal(t, int64(9), transferCount(outputDir))
+
+	outputDir, err = export(writeStore(block.MainChainId))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), transferCount(outputDir))
+
+	unknownChain := writeStore(42)
+	_, err = export(unknownChain)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "chain 42 whose MultiToken contract is unknown")
+
+	outputDir, err = export(unknownChain, "--token-contract", tdvvToken)
+	require.NoError(t, err)
+	assert.Equal(t, int64(9), transferCount(outputDir))
+}
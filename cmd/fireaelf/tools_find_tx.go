@@ -0,0 +1,89 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	"go.uber.org/zap"
+)
+
+var errTransactionFound = errors.New("transaction found")
+
+func newToolsFindTxCmd(zlog *zap.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "find-tx <transaction_id>",
+		Short: "Finds a transaction in merged blocks and prints its trace with call tree, logs and state changes",
+		Args:  cobra.ExactArgs(1),
+		RunE:  toolsFindTxE(zlog),
+	}
+
+	cmd.Flags().String("store", "./firehose-data/storage/merged-blocks", "URL of the merged blocks store to scan")
+	cmd.Flags().StringP("range", "r", "", "Inclusive block range to scan, required, e.g. '1000:2000' (open ranges like '1000:' scan until the last merged blocks file)")
+	cmd.Flags().StringP("output", "o", "text", "Output mode, either 'text' or 'json'")
+
+	return cmd
+}
+
+func toolsFindTxE(zlog *zap.Logger) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		transactionId := strings.ToLower(strings.TrimPrefix(args[0], "0x"))
+
+		output := sflags.MustGetString(cmd, "output")
+		if output != "text" && output != "json" {
+			return fmt.Errorf("invalid output mode %q, expecting 'text' or 'json'", output)
+		}
+
+		// A transaction is looked up around its reference block, scanning a whole chain by default would take hours
+		if sflags.MustGetString(cmd, "range") == "" {
+			return fmt.Errorf("the --range flag is required, e.g. the blocks following the transaction ref_block_number")
+		}
+		startBlock, stopBlock, err := blockRangeFromFlag(cmd, "range")
+		if err != nil {
+			return err
+		}
+
+		storeURL := sflags.MustGetString(cmd, "store")
+		store, err := dstore.NewDBinStore(storeURL)
+		if err != nil {
+			return fmt.Errorf("unable to create store at path %q: %w", storeURL, err)
+		}
+
+		zlog.Info("scanning merged blocks for transaction", zap.String("transaction_id", transactionId), zap.Uint64("start_block", startBlock), zap.Uint64("stop_block", stopBlock))
+
+		err = walkMergedBlocks(cmd.Context(), store, startBlock, stopBlock, func(blk *pbbstream.Block) error {
+			block, err := decodeBlock(blk)
+			if err != nil {
+				return err
+			}
+
+			for _, trace := range block.TransactionTraces {
+				if trace.TransactionId != transactionId {
+					continue
+				}
+
+				out := cmd.OutOrStdout()
+				if output == "json" {
+					fmt.Fprintln(out, formatMessageJSON(trace))
+				} else {
+					fmt.Fprintf(out, "Block #%d (%s)\n", block.Height, block.BlockHash)
+					printTransactionTraceText(out, trace, "", true)
+				}
+				return errTransactionFound
+			}
+			return nil
+		})
+
+		if errors.Is(err, errTransactionFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("transaction %s not found in the scanned range", transactionId)
+	}
+}
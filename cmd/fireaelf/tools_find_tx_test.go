@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/firehose-aelf/block"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"github.com/test-go/testify/assert"
+	"github.com/test-go/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestToolsFindTx(t *testing.T) {
+	ctx := context.Background()
+
+	mergedDir := t.TempDir()
+	mergedStore, err := dstore.NewDBinStore(mergedDir)
+	require.NoError(t, err)
+
+	transactionId := func(num uint64) string { return fmt.Sprintf("%064x", num) }
+	for _, base := range []uint64{0, 100} {
+		var blocks []*pbbstream.Block
+		for num := max(base, 1); num < base+mergedBlocksBundleSize; num++ {
+			payload, err := anypb.New(&pbaelf.Block{
+				Version: block.LatestVersion, Height: int64(num), BlockHash: fmt.Sprintf("%064d", num), Header: &pbaelf.BlockHeader{},
+				TransactionTraces: []*pbaelf.TransactionTrace{{
+					TransactionId: transactionId(num),
+					Calls:         []*pbaelf.Call{{CallPath: ":0", From: "user", To: "app", MethodName: "Do", ExecutionStatus: pbaelf.ExecutionStatus_EXECUTED}},
+				}},
+			})
+			require.NoError(t, err)
+			blocks = append(blocks, &pbbstream.Block{Number: num, Id: fmt.Sprintf("%064d", num), ParentNum: num - 1, ParentId: fmt.Sprintf("%064d", num-1), Payload: payload})
+		}
+		require.NoError(t, writeBlocksFile(ctx, mergedStore, fmt.Sprintf("%010d", base), blocks))
+	}
+
+	findTx := func(args ...string) (string, error) {
+		var out bytes.Buffer
+		cmd := newToolsFindTxCmd(zap.NewNop())
+		cmd.SetArgs(append(args, "--store", mergedDir))
+		cmd.SetOut(&out)
+		cmd.SilenceUsage, cmd.SilenceErrors = true, true
+		err := cmd.ExecuteContext(ctx)
+		return out.String(), err
+	}
+
+	output, err := findTx("0x"+transactionId(150), "--range", "100:199")
+	require.NoError(t, err)
+	assert.Equal(t, "Block #150 ("+fmt.Sprintf("%064d", 150)+")\n"+
+		"- Transaction "+transactionId(150)+" (USER, EXECUTED)\n"+
+		"    :0 user -> app Do (EXECUTED)\n", output)
+
+	output, err = findTx(transactionId(150), "--range", "0:", "--output", "json")
+	require.NoError(t, err)
+	assert.Contains(t, output, `"transactionId":"`+transactionId(150)+`"`)
+
+	_, err = findTx(transactionId(250), "--range", "0:")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found in the scanned range")
+
+	// The range bounds are inclusive
+	_, err = findTx(transactionId(150), "--range", "150:150")
+	require.NoError(t, err)
+	_, err = findTx(transactionId(150), "--range", "100:149")
+	require.Error(t, err)
+	_, err = findTx(transactionId(150), "--range", "151:199")
+	require.Error(t, err)
+	_, err = findTx(transactionId(99), "--range", "99:99")
+	require.NoError(t, err)
+
+	// A range starting past the store is not a miss
+	_, err = findTx(transactionId(250), "--range", "200:")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "merged blocks file 0000000200 not found")
+
+	_, err = findTx(transactionId(150))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--range flag is required")
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	addressFullName   = new(aelf.Address).ProtoReflect().Descriptor().FullName()
+	hashFullName      = new(aelf.Hash).ProtoReflect().Descriptor().FullName()
+	timestampFullName = new(timestamppb.Timestamp).ProtoReflect().Descriptor().FullName()
+)
+
+// formatMessageJSON renders message as JSON the AElf way: addresses in base58, hashes and bytes in hex and
+// timestamps in RFC3339. Fields are written in declaration order and unpopulated fields are skipped.
+func formatMessageJSON(message proto.Message) string {
+	writer := &jsonWriter{buffer: new(bytes.Buffer)}
+	writer.writeMessage(message.ProtoReflect())
+	return writer.buffer.String()
+}
+
+// formatDecodedMessageJSON is formatMessageJSON also writing, next to their raw bytes, the decoded params
+// of calls (`decodedParams`) and the decoded log events (`decoded`) when their message is known.
+func formatDecodedMessageJSON(message proto.Message) string {
+	writer := &jsonWriter{buffer: new(bytes.Buffer), decodePayloads: true}
+	writer.writeMessage(message.ProtoReflect())
+	return writer.buffer.String()
+}
+
+// decodeKnownEvent returns the AElf JSON rendering of event when it's a known event, false otherwise.
+func decodeKnownEvent(event *pbaelf.LogEvent) (string, bool) {
+	message, found := decodeKnownEventMessage(event)
+	if !found {
+		return "", false
+	}
+	return formatMessageJSON(message), true
+}
+
+func decodeKnownEventMessage(event *pbaelf.LogEvent) (proto.Message, bool) {
+	message, found := aelf.NewKnownEvent(event.Name)
+	if !found {
+		return nil, false
+	}
+	if err := event.Decode(message); err != nil {
+		return nil, false
+	}
+	return message, true
+}
+
+func decodeKnownCallParams(call *pbaelf.Call) (proto.Message, bool) {
+	message, found := aelf.NewKnownMethodInput(call.MethodName)
+	if !found {
+		return nil, false
+	}
+	if err := proto.Unmarshal(call.Params, message); err != nil {
+		return nil, false
+	}
+	return message, true
+}
+
+type jsonWriter struct {
+	buffer         *bytes.Buffer
+	decodePayloads bool
+}
+
+func (w *jsonWriter) writeMessage(message protoreflect.Message) {
+	switch message.Descriptor().FullName() {
+	case addressFullName:
+		w.writeString(message.Interface().(*aelf.Address).ToBase58())
+		return
+	case hashFullName:
+		w.writeString(message.Interface().(*aelf.Hash).ToHex())
+		return
+	case timestampFullName:
+		w.writeString(message.Interface().(*timestamppb.Timestamp).AsTime().UTC().Format(time.RFC3339Nano))
+		return
+	}
+
+	w.buffer.WriteByte('{')
+	fields := message.Descriptor().Fields()
+	first := true
+	writeKey := func(key string) {
+		if !first {
+			w.buffer.WriteByte(',')
+		}
+		first = false
+
+		w.writeString(key)
+		w.buffer.WriteByte(':')
+	}
+
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if !message.Has(field) {
+			continue
+		}
+		writeKey(field.JSONName())
+		w.writeField(field, message.Get(field))
+	}
+
+	if w.decodePayloads {
+		var key string
+		var decoded proto.Message
+		var found bool
+		switch payload := message.Interface().(type) {
+		case *pbaelf.Call:
+			key = "decodedParams"
+			decoded, found = decodeKnownCallParams(payload)
+		case *pbaelf.LogEvent:
+			key = "decoded"
+			decoded, found = decodeKnownEventMessage(payload)
+		}
+		if found {
+			writeKey(key)
+			w.writeMessage(decoded.ProtoReflect())
+		}
+	}
+	w.buffer.WriteByte('}')
+}
+
+func (w *jsonWriter) writeField(field protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch {
+	case field.IsList():
+		list := value.List()
+		w.buffer.WriteByte('[')
+		for i := 0; i < list.Len(); i++ {
+			if i > 0 {
+				w.buffer.WriteByte(',')
+			}
+			w.writeValue(field, list.Get(i))
+		}
+		w.buffer.WriteByte(']')
+
+	case field.IsMap():
+		entries := value.Map()
+		var keys []protoreflect.MapKey
+		entries.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+			keys = append(keys, key)
+			return true
+		})
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+
+		w.buffer.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				w.buffer.WriteByte(',')
+			}
+			w.writeString(key.String())
+			w.buffer.WriteByte(':')
+			w.writeValue(field.MapValue(), entries.Get(key))
+		}
+		w.buffer.WriteByte('}')
+
+	default:
+		w.writeValue(field, value)
+	}
+}
+
+func (w *jsonWriter) writeValue(field protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch field.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		w.writeMessage(value.Message())
+	case protoreflect.BytesKind:
+		w.writeString(hex.EncodeToString(value.Bytes()))
+	case protoreflect.StringKind:
+		w.writeString(value.String())
+	case protoreflect.BoolKind:
+		w.buffer.WriteString(strconv.FormatBool(value.Bool()))
+	case protoreflect.EnumKind:
+		if enumValue := field.Enum().Values().ByNumber(value.Enum()); enumValue != nil {
+			w.writeString(string(enumValue.Name()))
+		} else {
+			w.buffer.WriteString(strconv.FormatInt(int64(value.Enum()), 10))
+		}
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		w.buffer.WriteString(strconv.FormatFloat(value.Float(), 'g', -1, 64))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		w.buffer.WriteString(strconv.FormatUint(value.Uint(), 10))
+	default:
+		w.buffer.WriteString(strconv.FormatInt(value.Int(), 10))
+	}
+}
+
+func (w *jsonWriter) writeString(value string) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		panic(fmt.Errorf("marshalling string: %w", err))
+	}
+	w.buffer.Write(data)
+}
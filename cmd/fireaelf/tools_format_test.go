@@ -0,0 +1,26 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"github.com/test-go/testify/assert"
+)
+
+func TestFormatDecodedMessageJSON(t *testing.T) {
+	to := &aelf.Address{Value: make([]byte, 32)}
+	call := &pbaelf.Call{
+		MethodName: "Transfer",
+		Params:     mustMarshal(t, &aelf.TransferInput{To: to, Symbol: "ELF", Amount: 100}),
+		Logs: []*pbaelf.LogEvent{
+			{Name: "Transferred", NonIndexed: mustMarshal(t, &aelf.Transferred{Amount: 100})},
+			{Name: "Unknown", NonIndexed: []byte{0x01}},
+		},
+	}
+
+	params := `"params":"` + "0a220a20" + "0000000000000000000000000000000000000000000000000000000000000000" + `1203454c461864"`
+	assert.Equal(t, `{"methodName":"Transfer",`+params+`,"logs":[{"name":"Transferred","nonIndexed":"2064"},{"name":"Unknown","nonIndexed":"01"}]}`, formatMessageJSON(call))
+	assert.Equal(t, `{"methodName":"Transfer",`+params+`,"logs":[{"name":"Transferred","nonIndexed":"2064","decoded":{"amount":100}},{"name":"Unknown","nonIndexed":"01"}],`+
+		`"decodedParams":{"to":"`+to.ToBase58()+`","symbol":"ELF","amount":100}}`, formatDecodedMessageJSON(call))
+}
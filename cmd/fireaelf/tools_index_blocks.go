@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	bstransform "github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/firehose-aelf/transform"
+	"go.uber.org/zap"
+)
+
+func newToolsIndexBlocksCmd(zlog *zap.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "index-blocks <merged_blocks_store> <index_store>",
+		Short: "Builds the block index files (contract address, method name, log event name and log address) out of merged blocks",
+		Long: "Builds the block index files out of merged blocks, the same files produced by the 'index-builder' app. Only complete " +
+			"index bundles are written, the blocks past the last full bundle of the range are not indexed.",
+		Args: cobra.ExactArgs(2),
+		RunE: toolsIndexBlocksE(zlog),
+	}
+
+	cmd.Flags().Uint64("index-size", 10000, "Size of the index bundles, the range start must be aligned on it")
+	cmd.Flags().StringP("range", "r", "", "Inclusive block range to index, e.g. '0:19999' (open ranges like '10000:' index until the last merged blocks file)")
+
+	return cmd
+}
+
+func toolsIndexBlocksE(zlog *zap.Logger) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		indexSize := sflags.MustGetUint64(cmd, "index-size")
+		if indexSize == 0 {
+			return fmt.Errorf("invalid index size 0")
+		}
+
+		startBlock, stopBlock, err := blockRangeFromFlag(cmd, "range")
+		if err != nil {
+			return err
+		}
+		if startBlock%indexSize != 0 {
+			return fmt.Errorf("range start block %d must be aligned on the index size %d", startBlock, indexSize)
+		}
+
+		blocksStore, err := dstore.NewDBinStore(args[0])
+		if err != nil {
+			return fmt.Errorf("unable to create store at path %q: %w", args[0], err)
+		}
+		indexStore, err := dstore.NewStore(args[1], "", "", false)
+		if err != nil {
+			return fmt.Errorf("unable to create index store at path %q: %w", args[1], err)
+		}
+
+		zlog.Info("indexing merged blocks", zap.Uint64("start_block", startBlock), zap.Uint64("stop_block", stopBlock), zap.Uint64("index_size", indexSize))
+
+		indexer := transform.NewAElfBlockIndexer(indexStore, indexSize, bstransform.WithDefinedStartBlock(startBlock))
+		return walkMergedBlocks(cmd.Context(), blocksStore, startBlock, stopBlock, func(blk *pbbstream.Block) error {
+			block, err := decodeBlock(blk)
+			if err != nil {
+				return err
+			}
+			return indexer.ProcessBlock(block)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/cli/sflags"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"github.com/streamingfast/firehose-core/firehose/client"
+	pbfirehose "github.com/streamingfast/pbgo/sf/firehose/v2"
+	"go.uber.org/zap"
+)
+
+func newToolsLoadSQLCmd(zlog *zap.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "load-sql <firehose_endpoint> <dsn>",
+		Short: "Streams blocks from a Firehose endpoint into PostgreSQL (postgres://...) or SQLite (sqlite://<path>) tables, resuming from the stored cursor",
+		Long: `Streams blocks from a Firehose endpoint into the blocks, transaction_traces, calls, logs and state_writes tables
+of a PostgreSQL or SQLite database, created when missing.
+
+Each block is written in one database transaction along with its Firehose cursor, saved in the cursors table under
+--cursor-name. When a cursor is stored, streaming resumes from it and --range start block is ignored. Blocks undone
+by a fork are deleted from all tables.`,
+		Args: cobra.ExactArgs(2),
+		RunE: toolsLoadSQLE(zlog),
+	}
+
+	cmd.Flags().StringP("api-token-env-var", "a", "FIREHOSE_API_TOKEN", "Look for a JWT in this environment variable to authenticate against endpoint (alternative to api-key-env-var)")
+	cmd.Flags().String("api-key-env-var", "FIREHOSE_API_KEY", "Look for an API key directly in this environment variable to authenticate against endpoint (alternative to api-token-env-var)")
+	cmd.Flags().BoolP("plaintext", "p", false, "Use plaintext connection to Firehose")
+	cmd.Flags().BoolP("insecure", "k", false, "Use SSL connection to Firehose but skip SSL certificate validation")
+	cmd.Flags().StringP("range", "r", "", "Inclusive block range to load, e.g. '1000:2000' (open ranges like '1000:' stream live blocks once caught up)")
+	cmd.Flags().Bool("final-blocks-only", false, "Only load irreversible blocks, no fork can then happen")
+	cmd.Flags().String("cursor-name", "default", "Name of the cursor stored in the cursors table, distinct loaders writing to the same database must use distinct names")
+
+	return cmd
+}
+
+func toolsLoadSQLE(zlog *zap.Logger) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		endpoint, dsn := args[0], args[1]
+
+		startBlock, stopBlock, err := blockRangeFromFlag(cmd, "range")
+		if err != nil {
+			return err
+		}
+
+		loader, err := newSQLLoader(dsn, sflags.MustGetString(cmd, "cursor-name"))
+		if err != nil {
+			return err
+		}
+		defer loader.close()
+
+		if err := loader.setup(ctx); err != nil {
+			return err
+		}
+		cursor, err := loader.cursor(ctx)
+		if err != nil {
+			return err
+		}
+
+		firehoseClient, closeFunc, callOpts, err := client.NewFirehoseClient(
+			endpoint,
+			os.Getenv(sflags.MustGetString(cmd, "api-token-env-var")),
+			os.Getenv(sflags.MustGetString(cmd, "api-key-env-var")),
+			sflags.MustGetBool(cmd, "insecure"),
+			sflags.MustGetBool(cmd, "plaintext"),
+		)
+		if err != nil {
+			return fmt.Errorf("create Firehose client: %w", err)
+		}
+		defer closeFunc()
+
+		request := &pbfirehose.Request{
+			StartBlockNum:   int64(startBlock),
+			Cursor:          cursor,
+			FinalBlocksOnly: sflags.MustGetBool(cmd, "final-blocks-only"),
+		}
+		if stopBlock != 0 {
+			request.StopBlockNum = stopBlock - 1
+		}
+
+		zlog.Info("loading blocks into database", zap.String("endpoint", endpoint), zap.String("dialect", loader.dialect.name), zap.Uint64("start_block", startBlock), zap.Uint64("stop_block", stopBlock), zap.Bool("resuming", cursor != ""))
+
+		stream, err := firehoseClient.Blocks(ctx, request, callOpts...)
+		if err != nil {
+			return fmt.Errorf("unable to start blocks stream: %w", err)
+		}
+
+		for {
+			response, err := stream.Recv()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				return fmt.Errorf("stream error: %w", err)
+			}
+
+			block := new(pbaelf.Block)
+			if err := response.Block.UnmarshalTo(block); err != nil {
+				return fmt.Errorf("unmarshal block: %w", err)
+			}
+
+			switch response.Step {
+			case pbfirehose.ForkStep_STEP_NEW, pbfirehose.ForkStep_STEP_FINAL:
+				err = loader.insertBlock(ctx, block, response.Cursor)
+			case pbfirehose.ForkStep_STEP_UNDO:
+				err = loader.undoBlock(ctx, block.BlockHash, block.Height, block.Header.PreviousBlockHash, response.Cursor)
+			default:
+				err = fmt.Errorf("unsupported fork step %s", response.Step)
+			}
+			if err != nil {
+				return fmt.Errorf("block #%d (%s): %w", block.Height, block.BlockHash, err)
+			}
+
+			zlog.Debug("block loaded", zap.Int64("block_num", block.Height), zap.String("block_hash", block.BlockHash), zap.Stringer("step", response.Step))
+		}
+	}
+}
@@ -0,0 +1,334 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	_ "github.com/glebarez/go-sqlite"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+)
+
+// sqlDialect holds what differs between the supported databases, the statements otherwise use the
+// SQL subset shared by PostgreSQL and SQLite (`ON CONFLICT` clauses included).
+type sqlDialect struct {
+	name          string
+	driver        string
+	timestampType string
+	placeholder   func(n int) string
+}
+
+var (
+	postgresDialect = &sqlDialect{
+		name:          "postgres",
+		driver:        "pgx",
+		timestampType: "TIMESTAMPTZ",
+		placeholder:   func(n int) string { return fmt.Sprintf("$%d", n) },
+	}
+
+	sqliteDialect = &sqlDialect{
+		name:          "sqlite",
+		driver:        "sqlite",
+		timestampType: "TIMESTAMP",
+		placeholder:   func(n int) string { return "?" },
+	}
+)
+
+// The tables rows are keyed by block hash so that loading a block again replaces exactly its rows and
+// undoing a forked block deletes exactly its rows, block_num is kept on every table for range queries. Column
+// types are the ones of the Parquet export rows, `{timestamp}` is replaced by the dialect timestamp type.
+var sqlSchema = []string{
+	`CREATE TABLE IF NOT EXISTS blocks (
+		block_hash TEXT NOT NULL PRIMARY KEY,
+		block_num BIGINT NOT NULL,
+		parent_hash TEXT NOT NULL,
+		timestamp {timestamp} NOT NULL,
+		chain_id INTEGER NOT NULL,
+		producer TEXT NOT NULL,
+		signer_pubkey TEXT NOT NULL,
+		merkle_tree_root_of_transactions TEXT NOT NULL,
+		merkle_tree_root_of_world_state TEXT NOT NULL,
+		merkle_tree_root_of_transaction_status TEXT NOT NULL,
+		consensus_behaviour TEXT NOT NULL,
+		round_number BIGINT NOT NULL,
+		term_number BIGINT NOT NULL,
+		transaction_count INTEGER NOT NULL,
+		failed_transaction_count INTEGER NOT NULL,
+		total_elapsed BIGINT NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS blocks_block_num_idx ON blocks (block_num)`,
+	`CREATE TABLE IF NOT EXISTS transaction_traces (
+		block_hash TEXT NOT NULL,
+		block_num BIGINT NOT NULL,
+		timestamp {timestamp} NOT NULL,
+		transaction_id TEXT NOT NULL,
+		transaction_index INTEGER NOT NULL,
+		kind TEXT NOT NULL,
+		status TEXT NOT NULL,
+		from_address TEXT NOT NULL,
+		to_address TEXT NOT NULL,
+		method_name TEXT NOT NULL,
+		params TEXT NOT NULL,
+		ref_block_number BIGINT NOT NULL,
+		ref_block_prefix TEXT NOT NULL,
+		signature TEXT NOT NULL,
+		error TEXT NOT NULL,
+		call_count INTEGER NOT NULL,
+		log_count INTEGER NOT NULL,
+		elapsed BIGINT NOT NULL,
+		PRIMARY KEY (block_hash, transaction_id)
+	)`,
+	`CREATE INDEX IF NOT EXISTS transaction_traces_transaction_id_idx ON transaction_traces (transaction_id)`,
+	`CREATE TABLE IF NOT EXISTS calls (
+		block_hash TEXT NOT NULL,
+		block_num BIGINT NOT NULL,
+		transaction_id TEXT NOT NULL,
+		call_path TEXT NOT NULL,
+		depth INTEGER NOT NULL,
+		from_address TEXT NOT NULL,
+		to_address TEXT NOT NULL,
+		method_name TEXT NOT NULL,
+		params TEXT NOT NULL,
+		execution_status TEXT NOT NULL,
+		is_reverted BOOLEAN NOT NULL,
+		return_value TEXT NOT NULL,
+		error TEXT NOT NULL,
+		PRIMARY KEY (block_hash, transaction_id, call_path)
+	)`,
+	`CREATE INDEX IF NOT EXISTS calls_to_address_idx ON calls (to_address, method_name)`,
+	`CREATE TABLE IF NOT EXISTS logs (
+		block_hash TEXT NOT NULL,
+		block_num BIGINT NOT NULL,
+		transaction_id TEXT NOT NULL,
+		call_path TEXT NOT NULL,
+		log_index INTEGER NOT NULL,
+		address TEXT NOT NULL,
+		name TEXT NOT NULL,
+		indexed TEXT NOT NULL,
+		non_indexed TEXT NOT NULL,
+		decoded TEXT NOT NULL,
+		is_reverted BOOLEAN NOT NULL,
+		PRIMARY KEY (block_hash, log_index)
+	)`,
+	`CREATE INDEX IF NOT EXISTS logs_address_idx ON logs (address, name)`,
+	`CREATE TABLE IF NOT EXISTS state_writes (
+		block_hash TEXT NOT NULL,
+		block_num BIGINT NOT NULL,
+		transaction_id TEXT NOT NULL,
+		call_path TEXT NOT NULL,
+		key TEXT NOT NULL,
+		value TEXT NOT NULL,
+		is_delete BOOLEAN NOT NULL,
+		is_reverted BOOLEAN NOT NULL,
+		PRIMARY KEY (block_hash, transaction_id, call_path, key, is_delete)
+	)`,
+	`CREATE TABLE IF NOT EXISTS cursors (
+		name TEXT NOT NULL PRIMARY KEY,
+		cursor TEXT NOT NULL,
+		block_num BIGINT NOT NULL,
+		block_hash TEXT NOT NULL
+	)`,
+}
+
+// sqlBlockTables are the tables holding block rows, the blocks table last as forked blocks are looked
+// up by height in it.
+var sqlBlockTables = []string{"transaction_traces", "calls", "logs", "state_writes", "blocks"}
+
+// sqlLoader writes blocks, and the Firehose cursor they were received with, to a PostgreSQL or SQLite
+// database. Each block is written in its own database transaction with the cursor, so that a loader
+// restarted from the stored cursor never misses nor duplicates rows.
+type sqlLoader struct {
+	db         *sql.DB
+	dialect    *sqlDialect
+	cursorName string
+}
+
+// newSQLLoader opens the database at dsn, either a `postgres://` (or `postgresql://`) URL or a
+// `sqlite://<path>` one, `sqlite://:memory:` being an in-memory database.
+func newSQLLoader(dsn string, cursorName string) (*sqlLoader, error) {
+	dialect, dataSourceName := postgresDialect, dsn
+	switch {
+	case strings.HasPrefix(dsn, "postgres://"), strings.HasPrefix(dsn, "postgresql://"):
+	case strings.HasPrefix(dsn, "sqlite://"):
+		dialect, dataSourceName = sqliteDialect, strings.TrimPrefix(dsn, "sqlite://")
+	default:
+		return nil, fmt.Errorf("unsupported dsn %q, expected a postgres:// or sqlite:// URL", dsn)
+	}
+
+	db, err := sql.Open(dialect.driver, dataSourceName)
+	if err != nil {
+		return nil, fmt.Errorf("open %s database: %w", dialect.name, err)
+	}
+	if dialect == sqliteDialect {
+		// SQLite allows a single writer and each connection to `:memory:` is a distinct database
+		db.SetMaxOpenConns(1)
+	}
+
+	return &sqlLoader{db: db, dialect: dialect, cursorName: cursorName}, nil
+}
+
+// setup creates the tables and indexes that do not exist yet.
+func (l *sqlLoader) setup(ctx context.Context) error {
+	for _, statement := range sqlSchema {
+		if _, err := l.db.ExecContext(ctx, strings.ReplaceAll(statement, "{timestamp}", l.dialect.timestampType)); err != nil {
+			return fmt.Errorf("create schema: %w", err)
+		}
+	}
+	return nil
+}
+
+// cursor returns the cursor of the last block written, an empty string when none was.
+func (l *sqlLoader) cursor(ctx context.Context) (string, error) {
+	var cursor string
+	err := l.db.QueryRowContext(ctx, "SELECT cursor FROM cursors WHERE name = "+l.dialect.placeholder(1), l.cursorName).Scan(&cursor)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("read cursor %q: %w", l.cursorName, err)
+	}
+	return cursor, nil
+}
+
+// insertBlock writes the rows of block and saves cursor. Rows already written for block, possibly by an
+// older conversion, are replaced. A different block previously written at the same height, and the blocks
+// written above it, were on a fork that was never undone: their rows are deleted.
+func (l *sqlLoader) insertBlock(ctx context.Context, block *pbaelf.Block, cursor string) error {
+	return l.inTx(ctx, func(tx *sql.Tx) error {
+		p := l.dialect.placeholder
+		var forked int
+		err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM blocks WHERE block_num = "+p(1)+" AND block_hash <> "+p(2), block.Height, block.BlockHash).Scan(&forked)
+		if err != nil {
+			return fmt.Errorf("look up forked blocks: %w", err)
+		}
+		if forked > 0 {
+			err = l.deleteBlocks(ctx, tx, "block_num >= "+p(1), block.Height)
+		} else {
+			err = l.deleteBlocks(ctx, tx, "block_hash = "+p(1), block.BlockHash)
+		}
+		if err != nil {
+			return err
+		}
+
+		// The token transfers are not loaded
+		rows := newBlockRows(block, "")
+		blockHash, timestamp := block.BlockHash, block.Header.Time.AsTime().UTC()
+
+		b := rows.block
+		if err := l.insert(ctx, tx, "blocks", []string{"block_hash", "block_num", "parent_hash", "timestamp", "chain_id", "producer", "signer_pubkey",
+			"merkle_tree_root_of_transactions", "merkle_tree_root_of_world_state", "merkle_tree_root_of_transaction_status",
+			"consensus_behaviour", "round_number", "term_number", "transaction_count", "failed_transaction_count", "total_elapsed"},
+			blockHash, b.BlockNum, b.ParentHash, timestamp, b.ChainId, b.Producer, b.SignerPubkey,
+			b.TransactionsRoot, b.WorldStateRoot, b.TransactionStatusRoot,
+			b.ConsensusBehaviour, b.RoundNumber, b.TermNumber, b.TransactionCount, b.FailedTransactionCount, b.TotalElapsed,
+		); err != nil {
+			return err
+		}
+
+		for _, t := range rows.transactions {
+			if err := l.insert(ctx, tx, "transaction_traces", []string{"block_hash", "block_num", "timestamp", "transaction_id", "transaction_index", "kind", "status",
+				"from_address", "to_address", "method_name", "params", "ref_block_number", "ref_block_prefix", "signature", "error", "call_count", "log_count", "elapsed"},
+				blockHash, t.BlockNum, timestamp, t.TransactionId, t.Index, t.Kind, t.Status,
+				t.From, t.To, t.MethodName, t.Params, t.RefBlockNumber, t.RefBlockPrefix, t.Signature, t.Error, t.CallCount, t.LogCount, t.Elapsed,
+			); err != nil {
+				return err
+			}
+		}
+
+		for _, c := range rows.calls {
+			if err := l.insert(ctx, tx, "calls", []string{"block_hash", "block_num", "transaction_id", "call_path", "depth",
+				"from_address", "to_address", "method_name", "params", "execution_status", "is_reverted", "return_value", "error"},
+				blockHash, c.BlockNum, c.TransactionId, c.CallPath, c.Depth,
+				c.From, c.To, c.MethodName, c.Params, c.ExecutionStatus, c.IsReverted, c.ReturnValue, c.Error,
+			); err != nil {
+				return err
+			}
+		}
+
+		for _, e := range rows.logs {
+			if err := l.insert(ctx, tx, "logs", []string{"block_hash", "block_num", "transaction_id", "call_path", "log_index",
+				"address", "name", "indexed", "non_indexed", "decoded", "is_reverted"},
+				blockHash, e.BlockNum, e.TransactionId, e.CallPath, e.LogIndex,
+				e.Address, e.Name, e.Indexed, e.NonIndexed, e.Decoded, e.IsReverted,
+			); err != nil {
+				return err
+			}
+		}
+
+		for _, w := range rows.stateWrites {
+			if err := l.insert(ctx, tx, "state_writes", []string{"block_hash", "block_num", "transaction_id", "call_path", "key", "value", "is_delete", "is_reverted"},
+				blockHash, w.BlockNum, w.TransactionId, w.CallPath, w.Key, w.Value, w.IsDelete, w.IsReverted,
+			); err != nil {
+				return err
+			}
+		}
+
+		return l.saveCursor(ctx, tx, cursor, block.Height, block.BlockHash)
+	})
+}
+
+// undoBlock deletes the rows of the forked block blockHash and saves cursor, which points to the
+// parent block blockNum - 1 (parentHash).
+func (l *sqlLoader) undoBlock(ctx context.Context, blockHash string, blockNum int64, parentHash string, cursor string) error {
+	return l.inTx(ctx, func(tx *sql.Tx) error {
+		if err := l.deleteBlocks(ctx, tx, "block_hash = "+l.dialect.placeholder(1), blockHash); err != nil {
+			return err
+		}
+		return l.saveCursor(ctx, tx, cursor, blockNum-1, parentHash)
+	})
+}
+
+func (l *sqlLoader) close() error {
+	return l.db.Close()
+}
+
+func (l *sqlLoader) inTx(ctx context.Context, f func(tx *sql.Tx) error) error {
+	tx, err := l.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	if err := f(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+// deleteBlocks deletes the rows of the blocks matching the blocks table condition where.
+func (l *sqlLoader) deleteBlocks(ctx context.Context, tx *sql.Tx, where string, args ...any) error {
+	for _, table := range sqlBlockTables {
+		statement := fmt.Sprintf("DELETE FROM %s WHERE block_hash IN (SELECT block_hash FROM blocks WHERE %s)", table, where)
+		if _, err := tx.ExecContext(ctx, statement, args...); err != nil {
+			return fmt.Errorf("delete %s rows: %w", table, err)
+		}
+	}
+	return nil
+}
+
+func (l *sqlLoader) insert(ctx context.Context, tx *sql.Tx, table string, columns []string, values ...any) error {
+	placeholders := make([]string, len(columns))
+	for i := range columns {
+		placeholders[i] = l.dialect.placeholder(i + 1)
+	}
+
+	statement := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s) ON CONFLICT DO NOTHING", table, strings.Join(columns, ", "), strings.Join(placeholders, ", "))
+	if _, err := tx.ExecContext(ctx, statement, values...); err != nil {
+		return fmt.Errorf("insert %s row: %w", table, err)
+	}
+	return nil
+}
+
+func (l *sqlLoader) saveCursor(ctx context.Context, tx *sql.Tx, cursor string, blockNum int64, blockHash string) error {
+	p := l.dialect.placeholder
+	statement := fmt.Sprintf("INSERT INTO cursors (name, cursor, block_num, block_hash) VALUES (%s, %s, %s, %s) "+
+		"ON CONFLICT (name) DO UPDATE SET cursor = excluded.cursor, block_num = excluded.block_num, block_hash = excluded.block_hash", p(1), p(2), p(3), p(4))
+	if _, err := tx.ExecContext(ctx, statement, l.cursorName, cursor, blockNum, blockHash); err != nil {
+		return fmt.Errorf("save cursor %q: %w", l.cursorName, err)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"github.com/test-go/testify/assert"
+	"github.com/test-go/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSQLLoader(t *testing.T) {
+	ctx := context.Background()
+	blockTime := time.Date(2024, 11, 21, 6, 56, 57, 0, time.UTC)
+	newBlock := func(height int64, hash, parentHash string) *pbaelf.Block {
+		return &pbaelf.Block{
+			Height:    height,
+			BlockHash: hash,
+			Header:    &pbaelf.BlockHeader{Height: height, PreviousBlockHash: parentHash, Time: timestamppb.New(blockTime)},
+			TransactionTraces: []*pbaelf.TransactionTrace{
+				{TransactionId: "tx" + hash, Calls: []*pbaelf.Call{
+					{CallPath: ":0", To: "token", MethodName: "Transfer", ExecutionStatus: pbaelf.ExecutionStatus_EXECUTED,
+						StateSet: &pbaelf.TransactionExecutingStateSet{Writes: map[string][]byte{"a": {0x01}}},
+						Logs:     []*pbaelf.LogEvent{{Address: "token", Name: "Transferred"}}},
+				}},
+			},
+		}
+	}
+
+	_, err := newSQLLoader("mysql://localhost", "default")
+	require.Error(t, err)
+
+	loader, err := newSQLLoader("sqlite://"+filepath.Join(t.TempDir(), "aelf.db"), "default")
+	require.NoError(t, err)
+	defer loader.close()
+	require.NoError(t, loader.setup(ctx))
+	require.NoError(t, loader.setup(ctx), "schema creation is idempotent")
+
+	cursor, err := loader.cursor(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "", cursor)
+
+	count := func(table string) (count int) {
+		require.NoError(t, loader.db.QueryRow("SELECT COUNT(*) FROM "+table).Scan(&count))
+		return count
+	}
+	hashes := func() (hashes []string) {
+		rows, err := loader.db.Query("SELECT block_hash FROM blocks ORDER BY block_num")
+		require.NoError(t, err)
+		defer rows.Close()
+		for rows.Next() {
+			var hash string
+			require.NoError(t, rows.Scan(&hash))
+			hashes = append(hashes, hash)
+		}
+		return hashes
+	}
+
+	require.NoError(t, loader.insertBlock(ctx, newBlock(10, "a10", "a9"), "c1"))
+	require.NoError(t, loader.insertBlock(ctx, newBlock(11, "a11", "a10"), "c2"))
+	rewritten := newBlock(11, "a11", "a10")
+	rewritten.TransactionTraces[0].Calls[0].MethodName = "TransferFrom"
+	require.NoError(t, loader.insertBlock(ctx, rewritten, "c3"), "inserting a block again replaces its rows")
+	assert.Equal(t, []string{"a10", "a11"}, hashes())
+	for _, table := range []string{"transaction_traces", "calls", "logs", "state_writes"} {
+		assert.Equal(t, 2, count(table), table)
+	}
+
+	var method string
+	var reverted bool
+	require.NoError(t, loader.db.QueryRow("SELECT method_name, is_reverted FROM calls WHERE block_hash = 'a11'").Scan(&method, &reverted))
+	assert.Equal(t, "TransferFrom", method)
+	assert.False(t, reverted)
+
+	require.NoError(t, loader.undoBlock(ctx, "a11", 11, "a10", "c4"))
+	assert.Equal(t, []string{"a10"}, hashes())
+	assert.Equal(t, 1, count("calls"))
+
+	var blockNum int64
+	var blockHash string
+	require.NoError(t, loader.db.QueryRow("SELECT cursor, block_num, block_hash FROM cursors").Scan(&cursor, &blockNum, &blockHash))
+	assert.Equal(t, "c4", cursor)
+	assert.Equal(t, int64(10), blockNum)
+	assert.Equal(t, "a10", blockHash)
+
+	// A block forking a previously written one without undo replaces it along with its descendants
+	require.NoError(t, loader.insertBlock(ctx, newBlock(11, "b11", "a10"), "c5"))
+	require.NoError(t, loader.insertBlock(ctx, newBlock(12, "b12", "b11"), "c6"))
+	require.NoError(t, loader.insertBlock(ctx, newBlock(13, "b13", "b12"), "c7"))
+	require.NoError(t, loader.insertBlock(ctx, newBlock(11, "c11", "a10"), "c8"))
+	assert.Equal(t, []string{"a10", "c11"}, hashes())
+	for _, table := range []string{"transaction_traces", "calls", "logs", "state_writes"} {
+		assert.Equal(t, 2, count(table), table)
+	}
+
+	// Reloading an already written block keeps the blocks written above it
+	require.NoError(t, loader.insertBlock(ctx, newBlock(12, "c12", "c11"), "c9"))
+	require.NoError(t, loader.insertBlock(ctx, newBlock(11, "c11", "a10"), "c10"))
+	assert.Equal(t, []string{"a10", "c11", "c12"}, hashes())
+
+	cursor, err = loader.cursor(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "c10", cursor)
+}
@@ -0,0 +1,289 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+)
+
+// overrideToolsPrintCmd makes the `text` output mode of `tools print` subcommands AElf aware and adds the
+// `compact` output mode, the other output modes are still handled by firehose-core.
+func overrideToolsPrintCmd(toolsCmd *cobra.Command) error {
+	printCmd, _, err := toolsCmd.Find([]string{"print"})
+	if err != nil || printCmd == toolsCmd {
+		return fmt.Errorf("tools print command not found")
+	}
+
+	if flag := printCmd.PersistentFlags().Lookup("output"); flag != nil {
+		flag.Usage = "Output mode for block printing, either 'text', 'compact' (one line per transaction), 'json' or 'jsonl'"
+	}
+	if flag := printCmd.PersistentFlags().Lookup("transactions"); flag != nil {
+		flag.Usage = "When in 'text' output mode, also print transactions with their call tree, logs and decoded known events"
+	}
+
+	for _, subCmd := range printCmd.Commands() {
+		var printE func(cmd *cobra.Command, args []string, printBlock func(blk *pbbstream.Block) error) error
+		switch subCmd.Name() {
+		case "one-block":
+			printE = printOneBlockE
+		case "merged-blocks":
+			printE = printMergedBlocksE
+		default:
+			continue
+		}
+
+		originalRunE := subCmd.RunE
+		subCmd.RunE = func(cmd *cobra.Command, args []string) error {
+			printTransactions := sflags.MustGetBool(cmd, "transactions")
+
+			switch sflags.MustGetString(cmd, "output") {
+			case "text":
+				return printE(cmd, args, func(blk *pbbstream.Block) error {
+					return printBlockText(cmd.OutOrStdout(), blk, printTransactions)
+				})
+			case "compact":
+				return printE(cmd, args, func(blk *pbbstream.Block) error {
+					return printBlockCompact(cmd.OutOrStdout(), blk)
+				})
+			default:
+				return originalRunE(cmd, args)
+			}
+		}
+	}
+	return nil
+}
+
+func printOneBlockE(cmd *cobra.Command, args []string, printBlock func(blk *pbbstream.Block) error) error {
+	store, err := dstore.NewDBinStore(args[0])
+	if err != nil {
+		return fmt.Errorf("unable to create store at path %q: %w", args[0], err)
+	}
+
+	blockNum, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("unable to parse block number %q: %w", args[1], err)
+	}
+
+	return readOneBlockFiles(cmd.Context(), store, blockNum, printBlock)
+}
+
+func printMergedBlocksE(cmd *cobra.Command, args []string, printBlock func(blk *pbbstream.Block) error) error {
+	store, err := dstore.NewDBinStore(args[0])
+	if err != nil {
+		return fmt.Errorf("unable to create store at path %q: %w", args[0], err)
+	}
+
+	startBlock, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid base block %q: %w", args[1], err)
+	}
+
+	base := startBlock - startBlock%mergedBlocksBundleSize
+	return readBlocksFile(cmd.Context(), store, fmt.Sprintf("%010d", base), printBlock)
+}
+
+func printBlockText(out io.Writer, blk *pbbstream.Block, printTransactions bool) error {
+	block, err := decodeBlock(blk)
+	if err != nil {
+		return err
+	}
+
+	header := block.Header
+	fmt.Fprintf(out, "Block #%d (%s)\n", block.Height, block.BlockHash)
+	fmt.Fprintf(out, "  - Parent: #%d (%s)\n", blk.ParentNum, header.PreviousBlockHash)
+	fmt.Fprintf(out, "  - LIB: #%d\n", blk.LibNum)
+	fmt.Fprintf(out, "  - Time: %s\n", header.Time.AsTime().UTC().Format(time.RFC3339Nano))
+	fmt.Fprintf(out, "  - Producer: %s (%s)\n", aelf.AddressFromPublicKey(header.SignerPubkey).ToBase58(), hex.EncodeToString(header.SignerPubkey))
+	if consensus := header.Consensus; consensus != nil {
+		fmt.Fprintf(out, "  - Consensus: %s, round %d, term %d, %d miners\n", consensus.Behaviour, consensus.RoundNumber, consensus.TermNumber, len(consensus.Miners))
+	}
+	if transition := block.ConsensusTransition; transition != nil {
+		kind := "round"
+		if transition.IsNewTerm {
+			kind = "term"
+		}
+		fmt.Fprintf(out, "  - New %s: round %d, term %d, miners %s\n", kind, transition.RoundNumber, transition.TermNumber, formatMiners(transition.Miners))
+	}
+	if stats := block.Stats; stats != nil {
+		fmt.Fprintf(out, "  - Transactions: %d (%d user, %d system, %d failed), %d calls, %d logs, %d state writes, %d state deletes\n",
+			stats.TransactionCount, stats.UserTransactionCount, stats.SystemTransactionCount, stats.FailedTransactionCount,
+			stats.CallCount, stats.LogCount, stats.StateWriteCount, stats.StateDeleteCount)
+	}
+
+	if !printTransactions {
+		return nil
+	}
+
+	for _, trace := range block.TransactionTraces {
+		printTransactionTraceText(out, trace, "  ", false)
+	}
+	return nil
+}
+
+func printTransactionTraceText(out io.Writer, trace *pbaelf.TransactionTrace, indent string, printStateChanges bool) {
+	fmt.Fprintf(out, "%s- Transaction %s (%s, %s)\n", indent, trace.TransactionId, trace.Kind, transactionStatus(trace))
+	for _, node := range buildCallTree(trace.Calls) {
+		printCallNodeText(out, node, indent+"    ", printStateChanges)
+	}
+}
+
+func printCallNodeText(out io.Writer, node *callNode, indent string, printStateChanges bool) {
+	call := node.call
+
+	plugin := ""
+	if node.plugin != "" {
+		plugin = "[" + node.plugin + "] "
+	}
+	status := call.ExecutionStatus.String()
+	if call.IsReverted {
+		status += ", reverted"
+	}
+	fmt.Fprintf(out, "%s%s%s %s -> %s %s (%s)\n", indent, plugin, call.CallPath, call.From, call.To, call.MethodName, status)
+	if call.Error != "" {
+		fmt.Fprintf(out, "%s  error: %s\n", indent, strings.TrimSpace(call.Error))
+	}
+	if len(call.Params) > 0 {
+		fmt.Fprintf(out, "%s  params: %s\n", indent, hex.EncodeToString(call.Params))
+	}
+	for _, event := range call.Logs {
+		fmt.Fprintf(out, "%s  log %s @ %s %s\n", indent, event.Name, event.Address, formatLogEvent(event))
+	}
+	if printStateChanges {
+		writes := call.StateSet.GetWrites()
+		for _, key := range sortedKeys(writes) {
+			fmt.Fprintf(out, "%s  write %s = %s\n", indent, key, hex.EncodeToString(writes[key]))
+		}
+		for _, key := range sortedKeys(call.StateSet.GetDeletes()) {
+			fmt.Fprintf(out, "%s  delete %s\n", indent, key)
+		}
+	}
+
+	for _, child := range node.children {
+		printCallNodeText(out, child, indent+"  ", printStateChanges)
+	}
+}
+
+func sortedKeys[V any](entries map[string]V) []string {
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func printBlockCompact(out io.Writer, blk *pbbstream.Block) error {
+	block, err := decodeBlock(blk)
+	if err != nil {
+		return err
+	}
+
+	for _, trace := range block.TransactionTraces {
+		mainCall := mainCallOf(trace)
+		if mainCall == nil {
+			continue
+		}
+
+		logCount := 0
+		for _, call := range trace.Calls {
+			logCount += len(call.Logs)
+		}
+		fmt.Fprintf(out, "#%d %s %s %s %s -> %s %s calls=%d logs=%d\n", block.Height, trace.TransactionId, trace.Kind, transactionStatus(trace),
+			mainCall.From, mainCall.To, mainCall.MethodName, len(trace.Calls), logCount)
+	}
+	return nil
+}
+
+func formatLogEvent(event *pbaelf.LogEvent) string {
+	if decoded, found := decodeKnownEvent(event); found {
+		return decoded
+	}
+
+	var indexed []string
+	for _, data := range event.Indexed {
+		indexed = append(indexed, hex.EncodeToString(data))
+	}
+	return fmt.Sprintf("indexed=[%s] non_indexed=%s", strings.Join(indexed, ","), hex.EncodeToString(event.NonIndexed))
+}
+
+func formatMiners(miners []*pbaelf.Miner) string {
+	var pubkeys []string
+	for _, miner := range miners {
+		pubkeys = append(pubkeys, fmt.Sprintf("%d:%s", miner.Order, miner.Pubkey))
+	}
+	return "[" + strings.Join(pubkeys, ", ") + "]"
+}
+
+func mainCallOf(trace *pbaelf.TransactionTrace) *pbaelf.Call {
+	if int(trace.MainCallIndex) >= len(trace.Calls) {
+		return nil
+	}
+	return trace.Calls[trace.MainCallIndex]
+}
+
+func transactionStatus(trace *pbaelf.TransactionTrace) string {
+	mainCall := mainCallOf(trace)
+	if mainCall == nil {
+		return pbaelf.ExecutionStatus_UNDEFINED.String()
+	}
+	if mainCall.IsReverted && mainCall.ExecutionStatus == pbaelf.ExecutionStatus_EXECUTED {
+		return "REVERTED"
+	}
+	return mainCall.ExecutionStatus.String()
+}
+
+type callNode struct {
+	call *pbaelf.Call
+	// plugin is `pre` or `post` for plugin transactions, empty for inline calls
+	plugin   string
+	children []*callNode
+}
+
+// buildCallTree reconstructs the call tree of a transaction from the call paths of its flattened calls,
+// children keep the flattened order which is pre plugins, inline calls then post plugins.
+func buildCallTree(calls []*pbaelf.Call) []*callNode {
+	nodes := make(map[string]*callNode, len(calls))
+	for _, call := range calls {
+		nodes[call.CallPath] = &callNode{call: call}
+	}
+
+	var roots []*callNode
+	for _, call := range calls {
+		node := nodes[call.CallPath]
+		parentPath, plugin := parentCallPath(call.CallPath)
+		node.plugin = plugin
+
+		if parent, found := nodes[parentPath]; found && parent != node {
+			parent.children = append(parent.children, node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+	return roots
+}
+
+func parentCallPath(callPath string) (parentPath string, plugin string) {
+	index := strings.LastIndex(callPath, ":")
+	if index <= 0 {
+		return "", ""
+	}
+
+	parentPath = callPath[:index]
+	for _, plugin := range []string{"pre", "post"} {
+		if strings.HasSuffix(parentPath, ":"+plugin) {
+			return strings.TrimSuffix(parentPath, ":"+plugin), plugin
+		}
+	}
+	return parentPath, ""
+}
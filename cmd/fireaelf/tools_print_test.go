@@ -0,0 +1,180 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/firehose-aelf/block"
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"github.com/test-go/testify/assert"
+	"github.com/test-go/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestBuildCallTree(t *testing.T) {
+	calls := []*pbaelf.Call{
+		{CallPath: ":0:pre:0"},
+		{CallPath: ":0"},
+		{CallPath: ":0:0"},
+		{CallPath: ":0:0:0"},
+		{CallPath: ":0:1"},
+		{CallPath: ":0:post:0"},
+		{CallPath: ":0:post:0:0"},
+	}
+
+	roots := buildCallTree(calls)
+	require.Len(t, roots, 1)
+	root := roots[0]
+	assert.Equal(t, ":0", root.call.CallPath)
+
+	require.Len(t, root.children, 4)
+	assert.Equal(t, ":0:pre:0", root.children[0].call.CallPath)
+	assert.Equal(t, "pre", root.children[0].plugin)
+	assert.Equal(t, ":0:0", root.children[1].call.CallPath)
+	assert.Equal(t, "", root.children[1].plugin)
+	assert.Equal(t, ":0:1", root.children[2].call.CallPath)
+	assert.Equal(t, ":0:post:0", root.children[3].call.CallPath)
+	assert.Equal(t, "post", root.children[3].plugin)
+
+	require.Len(t, root.children[1].children, 1)
+	assert.Equal(t, ":0:0:0", root.children[1].children[0].call.CallPath)
+	require.Len(t, root.children[3].children, 1)
+	assert.Equal(t, ":0:post:0:0", root.children[3].children[0].call.CallPath)
+}
+
+func TestFormatLogEvent(t *testing.T) {
+	from := &aelf.Address{Value: make([]byte, 32)}
+	event := &pbaelf.LogEvent{
+		Name:       "Transferred",
+		Indexed:    [][]byte{mustMarshal(t, &aelf.Transferred{From: from}), mustMarshal(t, &aelf.Transferred{Symbol: "ELF"})},
+		NonIndexed: mustMarshal(t, &aelf.Transferred{Amount: 100, Memo: "hi"}),
+	}
+	assert.Equal(t, `{"from":"`+from.ToBase58()+`","symbol":"ELF","amount":100,"memo":"hi"}`, formatLogEvent(event))
+
+	unknown := &pbaelf.LogEvent{Name: "Unknown", Indexed: [][]byte{{0x01}}, NonIndexed: []byte{0x02, 0x03}}
+	assert.Equal(t, "indexed=[01] non_indexed=0203", formatLogEvent(unknown))
+}
+
+func mustMarshal(t *testing.T, message proto.Message) []byte {
+	data, err := proto.Marshal(message)
+	require.NoError(t, err)
+	return data
+}
+
+// newPrintTestBlock returns a converted block with a failed user transaction, made of a fee charging pre
+// plugin, its main call and a reverted inline transfer.
+func newPrintTestBlock(t *testing.T) *pbbstream.Block {
+	signer := append([]byte{0x04}, make([]byte, 64)...)
+	transferred := &pbaelf.LogEvent{
+		Address:    "token",
+		Name:       "Transferred",
+		Indexed:    [][]byte{mustMarshal(t, &aelf.Transferred{Symbol: "ELF"})},
+		NonIndexed: mustMarshal(t, &aelf.Transferred{Amount: 100}),
+	}
+	converted := &pbaelf.Block{
+		Version:   block.LatestVersion,
+		BlockHash: "aa",
+		Height:    16,
+		Header: &pbaelf.BlockHeader{
+			PreviousBlockHash: "bb",
+			Time:              timestamppb.New(time.Date(2024, 11, 21, 6, 56, 57, 0, time.UTC)),
+			SignerPubkey:      signer,
+			Consensus:         &pbaelf.ConsensusInfo{Behaviour: pbaelf.ConsensusBehaviour_UPDATE_VALUE, RoundNumber: 3, TermNumber: 1},
+		},
+		Stats: &pbaelf.BlockStats{TransactionCount: 1, UserTransactionCount: 1, FailedTransactionCount: 1, CallCount: 3, LogCount: 1},
+		TransactionTraces: []*pbaelf.TransactionTrace{{
+			TransactionId: "tx",
+			Kind:          pbaelf.TransactionKind_USER,
+			MainCallIndex: 1,
+			Calls: []*pbaelf.Call{
+				{CallPath: ":0:pre:0", From: "user", To: "token", MethodName: "ChargeTransactionFees", ExecutionStatus: pbaelf.ExecutionStatus_EXECUTED},
+				{CallPath: ":0", From: "user", To: "app", MethodName: "Purchase", Params: []byte{0x08, 0x01}, ExecutionStatus: pbaelf.ExecutionStatus_CONTRACT_ERROR, Error: "sold out\n", IsReverted: true},
+				{CallPath: ":0:0", From: "app", To: "token", MethodName: "TransferFrom", ExecutionStatus: pbaelf.ExecutionStatus_EXECUTED, IsReverted: true, Logs: []*pbaelf.LogEvent{transferred}},
+			},
+		}},
+	}
+	payload, err := anypb.New(converted)
+	require.NoError(t, err)
+	return &pbbstream.Block{Number: 16, Id: "aa", ParentNum: 15, ParentId: "bb", LibNum: 8, Payload: payload}
+}
+
+func TestPrintBlockText(t *testing.T) {
+	producer := aelf.AddressFromPublicKey(append([]byte{0x04}, make([]byte, 64)...)).ToBase58()
+	header := "Block #16 (aa)\n" +
+		"  - Parent: #15 (bb)\n" +
+		"  - LIB: #8\n" +
+		"  - Time: 2024-11-21T06:56:57Z\n" +
+		"  - Producer: " + producer + " (04" + strings.Repeat("00", 64) + ")\n" +
+		"  - Consensus: UPDATE_VALUE, round 3, term 1, 0 miners\n" +
+		"  - Transactions: 1 (1 user, 0 system, 1 failed), 3 calls, 1 logs, 0 state writes, 0 state deletes\n"
+
+	var out bytes.Buffer
+	require.NoError(t, printBlockText(&out, newPrintTestBlock(t), false))
+	assert.Equal(t, header, out.String())
+
+	out.Reset()
+	require.NoError(t, printBlockText(&out, newPrintTestBlock(t), true))
+	assert.Equal(t, header+
+		"  - Transaction tx (USER, CONTRACT_ERROR)\n"+
+		"      :0 user -> app Purchase (CONTRACT_ERROR, reverted)\n"+
+		"        error: sold out\n"+
+		"        params: 0801\n"+
+		"        [pre] :0:pre:0 user -> token ChargeTransactionFees (EXECUTED)\n"+
+		"        :0:0 app -> token TransferFrom (EXECUTED, reverted)\n"+
+		`          log Transferred @ token {"symbol":"ELF","amount":100}`+"\n",
+		out.String())
+}
+
+func TestPrintBlockCompact(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, printBlockCompact(&out, newPrintTestBlock(t)))
+	assert.Equal(t, "#16 tx USER CONTRACT_ERROR user -> app Purchase calls=3 logs=1\n", out.String())
+}
+
+func TestOverrideToolsPrintCmd(t *testing.T) {
+	ctx := context.Background()
+
+	storeDir := t.TempDir()
+	store, err := dstore.NewDBinStore(storeDir)
+	require.NoError(t, err)
+	require.NoError(t, writeBlocksFile(ctx, store, "0000000000", []*pbbstream.Block{newPrintTestBlock(t)}))
+
+	// Stands for the firehose-core `tools print` command, its own output modes are left to it
+	var originalCalled bool
+	toolsCmd, printCmd := &cobra.Command{Use: "tools"}, &cobra.Command{Use: "print"}
+	printCmd.PersistentFlags().String("output", "text", "")
+	printCmd.PersistentFlags().Bool("transactions", false, "")
+	printCmd.AddCommand(&cobra.Command{Use: "merged-blocks", RunE: func(cmd *cobra.Command, args []string) error {
+		originalCalled = true
+		return nil
+	}})
+	toolsCmd.AddCommand(printCmd)
+	require.NoError(t, overrideToolsPrintCmd(toolsCmd))
+
+	print := func(flags ...string) string {
+		var out bytes.Buffer
+		toolsCmd.SetArgs(append([]string{"print", "merged-blocks", storeDir, "16"}, flags...))
+		toolsCmd.SetOut(&out)
+		toolsCmd.SilenceUsage, toolsCmd.SilenceErrors = true, true
+		require.NoError(t, toolsCmd.ExecuteContext(ctx))
+		return out.String()
+	}
+
+	assert.Equal(t, "#16 tx USER CONTRACT_ERROR user -> app Purchase calls=3 logs=1\n", print("--output", "compact"))
+	assert.Contains(t, print("--output", "text", "--transactions"), "        :0:0 app -> token TransferFrom (EXECUTED, reverted)\n")
+	assert.False(t, originalCalled)
+
+	assert.Empty(t, print("--output", "json"))
+	assert.True(t, originalCalled)
+
+	require.Error(t, overrideToolsPrintCmd(&cobra.Command{Use: "tools"}))
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/bstream"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/firehose-aelf/block"
+	"go.uber.org/zap"
+)
+
+func newToolsReconvertRawBlocksCmd(zlog *zap.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reconvert-raw-blocks <raw_blocks_store> <merged_blocks_store>",
+		Short: "Converts the raw aelf.Block one-block files archived by the reader node into merged blocks of the current sf.aelf.type.v1.Block schema",
+		Long: "Converts the raw aelf.Block one-block files written by the reader node to its --reader-node-raw-blocks-store-url " +
+			"into merged blocks, with the converter of this binary, overwriting the existing merged blocks files. Forked blocks " +
+			"are left out by following the parent of each block down from the highest block without fork candidate of the " +
+			"next bundle. Only complete bundles are written, an open range stops at the first incomplete one.",
+		Args: cobra.ExactArgs(2),
+		RunE: toolsReconvertRawBlocksE(zlog),
+	}
+
+	cmd.Flags().Int32("output-version", block.LatestVersion, "Output schema version (Block.version) of the converted blocks")
+	cmd.Flags().StringP("range", "r", "", "Inclusive block range to convert, aligned on the merged blocks bundle size, e.g. '0:999' (open ranges like '1000:' convert until the last complete bundle)")
+	cmd.Flags().StringArray("system-contracts", nil, "System contracts of a chain, as '<chain_id>:<multi_token_address>:<cross_chain_address>', as the reader node --reader-node-system-contracts flag, those of the AELF (9992731) and tDVV (1866392) chains are known, can be repeated")
+
+	return cmd
+}
+
+func toolsReconvertRawBlocksE(zlog *zap.Logger) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		startBlock, stopBlock, err := blockRangeFromFlag(cmd, "range")
+		if err != nil {
+			return err
+		}
+		if err := registerSystemContracts(sflags.MustGetStringArray(cmd, "system-contracts")); err != nil {
+			return err
+		}
+		if startBlock%mergedBlocksBundleSize != 0 || stopBlock%mergedBlocksBundleSize != 0 {
+			return fmt.Errorf("range must cover whole merged blocks bundles of %d blocks, e.g. '0:999'", mergedBlocksBundleSize)
+		}
+
+		rawStore, err := dstore.NewDBinStore(args[0])
+		if err != nil {
+			return fmt.Errorf("unable to create raw blocks store at path %q: %w", args[0], err)
+		}
+		mergedStore, err := dstore.NewDBinStore(args[1])
+		if err != nil {
+			return fmt.Errorf("unable to create merged blocks store at path %q: %w", args[1], err)
+		}
+
+		zlog.Info("reconverting raw blocks", zap.Uint64("start_block", startBlock), zap.Uint64("stop_block", stopBlock))
+
+		converter := newConverter()
+		converter.version = sflags.MustGetInt32(cmd, "output-version")
+		for base := startBlock; stopBlock == 0 || base < stopBlock; base += mergedBlocksBundleSize {
+			filenames, err := canonicalRawBlockFiles(ctx, rawStore, base)
+			if err != nil {
+				return err
+			}
+			if filenames == nil {
+				if stopBlock == 0 {
+					zlog.Info("reached the last complete bundle of raw blocks", zap.Uint64("next_bundle", base))
+					return nil
+				}
+				return fmt.Errorf("raw blocks of bundle %010d are incomplete, or end with a fork, in store %s", base, rawStore.BaseURL())
+			}
+
+			var blocks []*pbbstream.Block
+			for _, filename := range filenames {
+				err := readBlocksFile(ctx, rawStore, filename, func(blk *pbbstream.Block) error {
+					converted, err := converter.convert(blk)
+					if err != nil {
+						return err
+					}
+					blocks = append(blocks, converted)
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			if err := writeBlocksFile(ctx, mergedStore, fmt.Sprintf("%010d", base), blocks); err != nil {
+				return err
+			}
+			zlog.Info("merged blocks file written", zap.Uint64("base", base), zap.Int("block_count", len(blocks)))
+		}
+		return nil
+	}
+}
+
+type rawBlockFile struct {
+	filename string
+	id       string
+	parentId string
+}
+
+// canonicalRawBlockFiles returns, in block order, the one-block files of the bundle starting at base that
+// are part of the canonical chain, nil when the bundle is not complete. The chain is followed down from
+// the highest block of the bundle, or of the next one, that has a single candidate. Files of the same
+// block written more than once are only returned once and, AElf chains starting at height 1, block 0
+// is never expected.
+func canonicalRawBlockFiles(ctx context.Context, store dstore.Store, base uint64) ([]string, error) {
+	candidates := map[uint64][]*rawBlockFile{}
+	for _, bundle := range []uint64{base, base + mergedBlocksBundleSize} {
+		err := store.Walk(ctx, fmt.Sprintf("%010d", bundle)[:8], func(filename string) error {
+			blockNum, id, parentId, _, _, err := bstream.ParseFilename(filename)
+			if err != nil {
+				return fmt.Errorf("invalid one-block filename %q: %w", filename, err)
+			}
+			for _, candidate := range candidates[blockNum] {
+				if candidate.id == id {
+					return nil
+				}
+			}
+			candidates[blockNum] = append(candidates[blockNum], &rawBlockFile{filename: filename, id: id, parentId: parentId})
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("walk raw blocks of bundle %010d: %w", bundle, err)
+		}
+	}
+
+	heights := make([]uint64, 0, len(candidates))
+	for height := range candidates {
+		heights = append(heights, height)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] > heights[j] })
+
+	// A gap in the next bundle, e.g. after a reader restart, only discards the heads above it
+	lastBlock := base + mergedBlocksBundleSize - 1
+	for _, headNum := range heights {
+		if headNum < lastBlock {
+			return nil, nil
+		}
+		if len(candidates[headNum]) != 1 {
+			continue
+		}
+
+		filenames := make([]string, mergedBlocksBundleSize)
+		for current, num := candidates[headNum][0], headNum; current != nil; num-- {
+			if num <= lastBlock {
+				filenames[num-base] = current.filename
+			}
+			if num == base || num == 1 {
+				return filenames[num-base:], nil
+			}
+
+			parent := findRawBlockFile(candidates[num-1], current.parentId)
+			if parent == nil && num-1 <= lastBlock {
+				return nil, fmt.Errorf("raw block #%d (%s), parent of #%d, not found in store %s", num-1, current.parentId, num, store.BaseURL())
+			}
+			current = parent
+		}
+	}
+	return nil, nil
+}
+
+func findRawBlockFile(candidates []*rawBlockFile, id string) *rawBlockFile {
+	for _, candidate := range candidates {
+		if candidate.id == id {
+			return candidate
+		}
+	}
+	return nil
+}
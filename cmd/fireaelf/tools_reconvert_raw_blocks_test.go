@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/firehose-aelf/block"
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	"github.com/test-go/testify/assert"
+	"github.com/test-go/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestReconvertRawBlocks(t *testing.T) {
+	ctx := context.Background()
+
+	rawDir, mergedDir := t.TempDir(), t.TempDir()
+	rawStore, err := dstore.NewDBinStore(rawDir)
+	require.NoError(t, err)
+
+	blockId := func(num uint64, fork string) string { return fmt.Sprintf("%063d%s", num, fork) }
+	writeRawBlock := func(num uint64, fork, parentFork string) {
+		blk := newRawTestBlock(t, num, blockId(num, fork), blockId(num-1, parentFork))
+		require.NoError(t, writeOneBlockFile(ctx, rawStore, blk, "default"))
+	}
+
+	// One-block filenames only hold the last 16 characters of the block ids
+	for num := uint64(1); num <= 205; num++ {
+		switch {
+		case num == 150:
+			writeRawBlock(num, "b", "a")
+			writeRawBlock(num, "c", "a")
+			writeRawBlock(num, "c", "a")
+		case num == 151:
+			writeRawBlock(num, "a", "c")
+		case num == 201 || num == 202:
+			// gap in the lookahead bundle
+		default:
+			writeRawBlock(num, "a", "a")
+		}
+	}
+
+	reconvert := func(blockRange string, flags ...string) error {
+		cmd := newToolsReconvertRawBlocksCmd(zap.NewNop())
+		cmd.SetArgs(append([]string{rawDir, mergedDir, "--range", blockRange}, flags...))
+		cmd.SilenceUsage, cmd.SilenceErrors = true, true
+		return cmd.ExecuteContext(ctx)
+	}
+
+	require.Error(t, reconvert("0:149"), "range not aligned on bundles")
+	require.Error(t, reconvert("200:299"), "incomplete bundle")
+	require.Error(t, reconvert("0:", "--system-contracts", "4242:invalid:invalid"))
+	require.NoError(t, reconvert("0:", "--system-contracts", "4242:"+testMultiToken+":"+testCrossChain))
+	assert.Equal(t, block.SystemContracts{MultiToken: testMultiToken, CrossChain: testCrossChain}, block.SystemContractsOf(4242))
+
+	mergedStore, err := dstore.NewDBinStore(mergedDir)
+	require.NoError(t, err)
+
+	var ids []string
+	require.NoError(t, walkMergedBlocks(ctx, mergedStore, 0, 200, func(blk *pbbstream.Block) error {
+		block, err := decodeBlock(blk)
+		require.NoError(t, err)
+		assert.Equal(t, int64(blk.Number), block.Height)
+		assert.Equal(t, blk.Id, block.BlockHash)
+		ids = append(ids, blk.Id)
+		return nil
+	}))
+	require.Len(t, ids, 199)
+	assert.Equal(t, blockId(1, "a"), ids[0])
+	assert.Equal(t, blockId(150, "c"), ids[149])
+
+	exists, err := mergedStore.FileExists(ctx, "0000000200")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+// Valid addresses, standing for the system contracts of a chain without known ones
+const (
+	testMultiToken = "ASh2Wt7nSEmYqnGxPPzp4pnVDU4uhj1XW9Se5VeZcX2UDdyjx"
+	testCrossChain = "2PC7Jhb5V6iZXxz8uQUWvWubYkAoCVhtRGSL7VhTWX85R8DBuN"
+)
+
+// newRawTestBlock returns a block holding, as the reader node reads it, the raw aelf.Block payload.
+func newRawTestBlock(t testing.TB, num uint64, id, parentId string) *pbbstream.Block {
+	blockTime := time.Date(2024, 11, 21, 6, 56, 57, 0, time.UTC)
+	payload, err := anypb.New(&aelf.Block{
+		Header: &aelf.BlockHeader{Height: int64(num), Time: timestamppb.New(blockTime)},
+		Body:   &aelf.BlockBody{}, FirehoseBody: &aelf.FirehoseBlockBody{},
+	})
+	require.NoError(t, err)
+
+	return &pbbstream.Block{
+		Number: num, Id: id, ParentNum: num - 1, ParentId: parentId,
+		Timestamp: timestamppb.New(blockTime), Payload: payload,
+	}
+}
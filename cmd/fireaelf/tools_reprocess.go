@@ -0,0 +1,290 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"sort"
+	"sync/atomic"
+
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/bstream"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/firehose-aelf/block"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+)
+
+func newToolsReprocessCmd(zlog *zap.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reprocess <source_store> <destination_store>",
+		Short: "Re-runs the block conversion over the bundles of a merged blocks (or one-block) store, writing the merged blocks to a new store",
+		Long: "Re-runs the block conversion over the bundles of a source store, in parallel, and writes them as merged blocks files " +
+			"with the same bundle boundaries to the destination store. Blocks holding the raw aelf.Block payload of the node, as " +
+			"archived by --reader-node-raw-blocks-store-url, are converted again with the converter of this binary, already " +
+			"converted blocks are migrated to --output-version, unless --require-raw is set. Each destination file is read " +
+			"back and its checksum compared with the written content.",
+		Args: cobra.ExactArgs(2),
+		RunE: toolsReprocessE(zlog),
+	}
+
+	cmd.Flags().StringP("range", "r", "", "Inclusive block range to reprocess, aligned on the merged blocks bundle size, e.g. '0:999' (open ranges like '1000:' reprocess until the last complete source bundle)")
+	cmd.Flags().Bool("one-block-source", false, "The source store holds one-block files, forked blocks are left out as done by 'tools reconvert-raw-blocks'")
+	cmd.Flags().Int("workers", 4, "Number of bundles reprocessed in parallel")
+	cmd.Flags().Bool("require-raw", false, "Fail on source blocks already converted instead of migrating them")
+	cmd.Flags().Int32("output-version", block.LatestVersion, "Output schema version (Block.version) of the reprocessed blocks")
+	cmd.Flags().StringArray("system-contracts", nil, "System contracts of a chain, as '<chain_id>:<multi_token_address>:<cross_chain_address>', as the reader node --reader-node-system-contracts flag, those of the AELF (9992731) and tDVV (1866392) chains are known, can be repeated")
+
+	return cmd
+}
+
+// reprocessStats counts, across workers, the blocks converted from their raw payload and the already
+// converted ones, migrated to the output version.
+type reprocessStats struct {
+	bundles   atomic.Uint64
+	converted atomic.Uint64
+	migrated  atomic.Uint64
+}
+
+type reprocessor struct {
+	source         dstore.Store
+	destination    dstore.Store
+	oneBlockSource bool
+	requireRaw     bool
+	converter      *ReaderWithConverter
+	stats          reprocessStats
+	zlog           *zap.Logger
+}
+
+func toolsReprocessE(zlog *zap.Logger) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		startBlock, stopBlock, err := blockRangeFromFlag(cmd, "range")
+		if err != nil {
+			return err
+		}
+		if startBlock%mergedBlocksBundleSize != 0 || stopBlock%mergedBlocksBundleSize != 0 {
+			return fmt.Errorf("range must cover whole merged blocks bundles of %d blocks, e.g. '0:999'", mergedBlocksBundleSize)
+		}
+		workers := sflags.MustGetInt(cmd, "workers")
+		if workers < 1 {
+			return fmt.Errorf("invalid workers count %d", workers)
+		}
+		if err := registerSystemContracts(sflags.MustGetStringArray(cmd, "system-contracts")); err != nil {
+			return err
+		}
+
+		source, err := dstore.NewDBinStore(args[0])
+		if err != nil {
+			return fmt.Errorf("unable to create source store at path %q: %w", args[0], err)
+		}
+		destination, err := dstore.NewDBinStore(args[1])
+		if err != nil {
+			return fmt.Errorf("unable to create destination store at path %q: %w", args[1], err)
+		}
+
+		r := &reprocessor{
+			source:         source,
+			destination:    destination,
+			oneBlockSource: sflags.MustGetBool(cmd, "one-block-source"),
+			requireRaw:     sflags.MustGetBool(cmd, "require-raw"),
+			converter:      newConverter(),
+			zlog:           zlog,
+		}
+		r.converter.version = sflags.MustGetInt32(cmd, "output-version")
+
+		bases, err := r.sourceBundles(ctx, startBlock, stopBlock)
+		if err != nil {
+			return err
+		}
+		zlog.Info("reprocessing bundles", zap.Int("bundle_count", len(bases)), zap.Uint64("start_block", startBlock), zap.Uint64("stop_block", stopBlock), zap.Int("workers", workers))
+
+		group, groupCtx := errgroup.WithContext(ctx)
+		group.SetLimit(workers)
+		for _, base := range bases {
+			base := base
+			group.Go(func() error {
+				return r.reprocessBundle(groupCtx, base)
+			})
+		}
+		if err := group.Wait(); err != nil {
+			return err
+		}
+
+		zlog.Info("reprocessing completed", zap.Uint64("bundles", r.stats.bundles.Load()), zap.Uint64("converted_blocks", r.stats.converted.Load()), zap.Uint64("migrated_blocks", r.stats.migrated.Load()))
+		return nil
+	}
+}
+
+// sourceBundles returns the sorted base blocks of the source bundles within [startBlock, stopBlock), a
+// stopBlock of 0 meaning until the last complete source bundle. A closed range must be entirely present in
+// source. The last one-block bundles of a live store are incomplete, or lack the following bundle to pick
+// their canonical head, an open range stops before them as 'tools reconvert-raw-blocks' does.
+func (r *reprocessor) sourceBundles(ctx context.Context, startBlock, stopBlock uint64) ([]uint64, error) {
+	found := map[uint64]bool{}
+	err := r.source.Walk(ctx, "", func(filename string) error {
+		blockNum, err := r.fileBlockNum(filename)
+		if err != nil {
+			return err
+		}
+		if base := blockNum - blockNum%mergedBlocksBundleSize; base >= startBlock && (stopBlock == 0 || base < stopBlock) {
+			found[base] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walk source store: %w", err)
+	}
+
+	var bases []uint64
+	for base := range found {
+		bases = append(bases, base)
+	}
+	sort.Slice(bases, func(i, j int) bool { return bases[i] < bases[j] })
+
+	if stopBlock != 0 {
+		for base := startBlock; base < stopBlock; base += mergedBlocksBundleSize {
+			if !found[base] {
+				return nil, fmt.Errorf("no source blocks for bundle %010d in store %s", base, r.source.BaseURL())
+			}
+		}
+		return bases, nil
+	}
+
+	for r.oneBlockSource && len(bases) > 0 {
+		last := bases[len(bases)-1]
+		filenames, err := canonicalRawBlockFiles(ctx, r.source, last)
+		if err != nil {
+			return nil, err
+		}
+		if filenames != nil {
+			break
+		}
+		r.zlog.Info("leaving out the incomplete last bundle of one-block files", zap.Uint64("bundle", last))
+		bases = bases[:len(bases)-1]
+	}
+	return bases, nil
+}
+
+// fileBlockNum returns the block number of a one-block file, the base block of a merged blocks file.
+func (r *reprocessor) fileBlockNum(filename string) (uint64, error) {
+	if r.oneBlockSource {
+		blockNum, _, _, _, _, err := bstream.ParseFilename(filename)
+		if err != nil {
+			return 0, fmt.Errorf("invalid one-block filename %q: %w", filename, err)
+		}
+		return blockNum, nil
+	}
+
+	var base uint64
+	if _, err := fmt.Sscanf(filename, "%010d", &base); err != nil || len(filename) != 10 {
+		return 0, fmt.Errorf("invalid merged blocks filename %q", filename)
+	}
+	return base, nil
+}
+
+func (r *reprocessor) reprocessBundle(ctx context.Context, base uint64) error {
+	filename := fmt.Sprintf("%010d", base)
+
+	var blocks []*pbbstream.Block
+	collect := func(blk *pbbstream.Block) error {
+		if blk.Number < base || blk.Number >= base+mergedBlocksBundleSize {
+			return fmt.Errorf("block #%d outside of bundle %s", blk.Number, filename)
+		}
+		reprocessed, err := r.reprocessBlock(blk)
+		if err != nil {
+			return err
+		}
+		blocks = append(blocks, reprocessed)
+		return nil
+	}
+
+	if r.oneBlockSource {
+		filenames, err := canonicalRawBlockFiles(ctx, r.source, base)
+		if err != nil {
+			return err
+		}
+		if filenames == nil {
+			return fmt.Errorf("one-block files of bundle %s are incomplete, or end with a fork, in store %s", filename, r.source.BaseURL())
+		}
+		for _, oneBlockFilename := range filenames {
+			if err := readBlocksFile(ctx, r.source, oneBlockFilename, collect); err != nil {
+				return err
+			}
+		}
+	} else if err := readBlocksFile(ctx, r.source, filename, collect); err != nil {
+		return err
+	}
+
+	content, err := encodeBlocksFile(blocks)
+	if err != nil {
+		return fmt.Errorf("encode bundle %s: %w", filename, err)
+	}
+	checksum := sha256.Sum256(content)
+	if err := r.destination.WriteObject(ctx, filename, bytes.NewReader(content)); err != nil {
+		return fmt.Errorf("write bundle %s: %w", filename, err)
+	}
+	if err := verifyBlocksFile(ctx, r.destination, filename, checksum); err != nil {
+		return err
+	}
+
+	r.stats.bundles.Add(1)
+	r.zlog.Info("bundle reprocessed", zap.String("bundle", filename), zap.Int("block_count", len(blocks)), zap.String("sha256", fmt.Sprintf("%x", checksum)))
+	return nil
+}
+
+// reprocessBlock converts blk from its raw payload, blocks already converted are migrated to the output
+// version.
+func (r *reprocessor) reprocessBlock(blk *pbbstream.Block) (*pbbstream.Block, error) {
+	if blk.Payload == nil || clean(blk.Payload.TypeUrl) != r.converter.toTypeUrl {
+		converted, err := r.converter.convert(blk)
+		if err != nil {
+			return nil, err
+		}
+		r.stats.converted.Add(1)
+		return converted, nil
+	}
+
+	if r.requireRaw {
+		return nil, fmt.Errorf("block #%d (%s) is already converted, its raw payload is not available", blk.Number, blk.Id)
+	}
+
+	converted, err := decodeBlock(blk)
+	if err != nil {
+		return nil, err
+	}
+	if converted.Version != r.converter.version {
+		if err := block.MigrateBlock(converted, r.converter.version); err != nil {
+			return nil, err
+		}
+		if blk.Payload.Value, err = proto.Marshal(converted); err != nil {
+			return nil, fmt.Errorf("marshal block #%d (%s): %w", blk.Number, blk.Id, err)
+		}
+	}
+	r.stats.migrated.Add(1)
+	return blk, nil
+}
+
+// verifyBlocksFile reads back filename from store and compares the checksum of its content with checksum.
+func verifyBlocksFile(ctx context.Context, store dstore.Store, filename string, checksum [sha256.Size]byte) error {
+	reader, err := store.OpenObject(ctx, filename)
+	if err != nil {
+		return fmt.Errorf("open written bundle %s: %w", filename, err)
+	}
+	defer reader.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, reader); err != nil {
+		return fmt.Errorf("read back bundle %s: %w", filename, err)
+	}
+	if !bytes.Equal(hash.Sum(nil), checksum[:]) {
+		return fmt.Errorf("checksum mismatch for bundle %s, written %x, read back %x", filename, checksum, hash.Sum(nil))
+	}
+	return nil
+}
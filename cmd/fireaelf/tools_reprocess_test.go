@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/firehose-aelf/block"
+	"github.com/test-go/testify/assert"
+	"github.com/test-go/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestReprocess(t *testing.T) {
+	ctx := context.Background()
+
+	sourceDir := t.TempDir()
+	source, err := dstore.NewDBinStore(sourceDir)
+	require.NoError(t, err)
+
+	blockId := func(num uint64) string { return fmt.Sprintf("%064d", num) }
+	for _, base := range []uint64{0, 100, 200} {
+		var blocks []*pbbstream.Block
+		for num := base; num < base+mergedBlocksBundleSize; num++ {
+			if num == 0 {
+				continue
+			}
+			blk := newRawTestBlock(t, num, blockId(num), blockId(num-1))
+			if base == 200 {
+				// already converted bundle, its raw payload is not available
+				blk, err = newConverter().convert(blk)
+				require.NoError(t, err)
+			}
+			blocks = append(blocks, blk)
+		}
+		require.NoError(t, writeBlocksFile(ctx, source, fmt.Sprintf("%010d", base), blocks))
+	}
+
+	reprocess := func(destinationDir string, flags ...string) error {
+		cmd := newToolsReprocessCmd(zap.NewNop())
+		cmd.SetArgs(append([]string{sourceDir, destinationDir}, flags...))
+		cmd.SilenceUsage, cmd.SilenceErrors = true, true
+		return cmd.ExecuteContext(ctx)
+	}
+
+	require.Error(t, reprocess(t.TempDir(), "--range", "0:399"), "bundle 300 missing")
+	require.Error(t, reprocess(t.TempDir(), "--range", "0:", "--require-raw"), "bundle 200 already converted")
+	require.Error(t, reprocess(t.TempDir(), "--range", "0:", "--system-contracts", "4243:invalid:invalid"))
+
+	destinationDir := t.TempDir()
+	require.NoError(t, reprocess(destinationDir, "--range", "0:", "--workers", "2", "--system-contracts", "4243:"+testMultiToken+":"+testCrossChain))
+	assert.Equal(t, testMultiToken, block.SystemContractsOf(4243).MultiToken)
+
+	destination, err := dstore.NewDBinStore(destinationDir)
+	require.NoError(t, err)
+
+	var count uint64
+	require.NoError(t, walkMergedBlocks(ctx, destination, 0, 0, func(blk *pbbstream.Block) error {
+		count++
+		assert.Equal(t, count, blk.Number)
+		assert.Equal(t, blockId(blk.Number), blk.Id)
+
+		block, err := decodeBlock(blk)
+		require.NoError(t, err)
+		assert.Equal(t, blockId(blk.Number), block.BlockHash)
+		return nil
+	}))
+	assert.Equal(t, uint64(299), count)
+}
+
+func TestReprocess_OutputVersion(t *testing.T) {
+	ctx := context.Background()
+
+	sourceDir, destinationDir := t.TempDir(), t.TempDir()
+	source, err := dstore.NewDBinStore(sourceDir)
+	require.NoError(t, err)
+
+	var blocks []*pbbstream.Block
+	for num := uint64(100); num < 200; num++ {
+		blk, err := newConverter().convert(newRawTestBlock(t, num, fmt.Sprintf("%064d", num), fmt.Sprintf("%064d", num-1)))
+		require.NoError(t, err)
+		blocks = append(blocks, blk)
+	}
+	require.NoError(t, writeBlocksFile(ctx, source, "0000000100", blocks))
+
+	cmd := newToolsReprocessCmd(zap.NewNop())
+	cmd.SetArgs([]string{sourceDir, destinationDir, "--output-version", "1"})
+	cmd.SilenceUsage, cmd.SilenceErrors = true, true
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	destination, err := dstore.NewDBinStore(destinationDir)
+	require.NoError(t, err)
+	require.NoError(t, walkMergedBlocks(ctx, destination, 100, 200, func(blk *pbbstream.Block) error {
+		block, err := decodeBlock(blk)
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), block.Version)
+		assert.Nil(t, block.Stats)
+		return nil
+	}))
+}
+
+func TestReprocess_OneBlockSource(t *testing.T) {
+	ctx := context.Background()
+
+	sourceDir := t.TempDir()
+	source, err := dstore.NewDBinStore(sourceDir)
+	require.NoError(t, err)
+
+	blockId := func(num uint64, fork string) string { return fmt.Sprintf("%063d%s", num, fork) }
+	// A live store: bundle 200 is still being written
+	for num := uint64(1); num <= 250; num++ {
+		require.NoError(t, writeOneBlockFile(ctx, source, newRawTestBlock(t, num, blockId(num, "a"), blockId(num-1, "a")), "default"))
+	}
+	// A forked block, left out
+	require.NoError(t, writeOneBlockFile(ctx, source, newRawTestBlock(t, 120, blockId(120, "b"), blockId(119, "a")), "default"))
+
+	reprocess := func(destinationDir string, flags ...string) error {
+		cmd := newToolsReprocessCmd(zap.NewNop())
+		cmd.SetArgs(append([]string{sourceDir, destinationDir, "--one-block-source"}, flags...))
+		cmd.SilenceUsage, cmd.SilenceErrors = true, true
+		return cmd.ExecuteContext(ctx)
+	}
+
+	err = reprocess(t.TempDir(), "--range", "0:299")
+	require.Error(t, err, "closed range with an incomplete bundle")
+	assert.Contains(t, err.Error(), "bundle 0000000200 are incomplete")
+
+	destinationDir := t.TempDir()
+	require.NoError(t, reprocess(destinationDir, "--range", "0:", "--workers", "2", "--system-contracts", "4243:"+testMultiToken+":"+testCrossChain))
+	assert.Equal(t, testMultiToken, block.SystemContractsOf(4243).MultiToken)
+
+	destination, err := dstore.NewDBinStore(destinationDir)
+	require.NoError(t, err)
+
+	var ids []string
+	require.NoError(t, walkMergedBlocks(ctx, destination, 0, 0, func(blk *pbbstream.Block) error {
+		ids = append(ids, blk.Id)
+		return nil
+	}))
+	require.Len(t, ids, 199)
+	assert.Equal(t, blockId(1, "a"), ids[0])
+	assert.Equal(t, blockId(120, "a"), ids[119])
+	assert.Equal(t, blockId(199, "a"), ids[198])
+
+	exists, err := destination.FileExists(ctx, "0000000200")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
@@ -0,0 +1,324 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/rand"
+	"time"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/firehose-aelf/block"
+	"github.com/streamingfast/firehose-aelf/pb/aelf"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const aelfBlockTypeUrl = "aelf.Block"
+
+type chainConfig struct {
+	chainId              int32
+	seed                 int64
+	firstBlock           uint64
+	transactionsPerBlock int
+	// failingTransactions is the ratio of user transactions failing
+	failingTransactions float64
+	// forkEvery is the interval, in blocks, between two forked blocks, 0 disabling forks
+	forkEvery uint64
+	libDepth  uint64
+}
+
+// syntheticChain produces the blocks of a synthetic AElf chain: each block has the UpdateValue consensus
+// transaction of its miner, along with the matching Consensus extra data of a single miner producing a round
+// per block, followed by user purchases, a call to an application contract transferring tokens through an
+// inline call, after a fee charging pre plugin.
+//
+// The tokens are those of the MultiToken contract known for the chain id (block.SystemContractsOf), so their
+// fees fill BlockStats.fees_by_symbol. Chains without known system contracts get a random token contract and
+// no fees. The chain has no cross chain transfer, Block.cross_chain stays empty.
+//
+// The blocks of a height, ids included, only depend on the seed, the node restarts where it stopped
+// producing the same chain. A forked block, sibling of the canonical one that is produced right after, is
+// produced every forkEvery blocks.
+type syntheticChain struct {
+	config chainConfig
+
+	minerPubkey       []byte
+	miner             *aelf.Address
+	consensusContract *aelf.Address
+	tokenContract     *aelf.Address
+	appContract       *aelf.Address
+	users             []*aelf.Address
+}
+
+func newSyntheticChain(config chainConfig) *syntheticChain {
+	random := rand.New(rand.NewSource(config.seed))
+	address := func() *aelf.Address {
+		value := make([]byte, 32)
+		random.Read(value)
+		return &aelf.Address{Value: value}
+	}
+
+	minerPubkey := make([]byte, 65)
+	random.Read(minerPubkey)
+	minerPubkey[0] = 0x04
+
+	chain := &syntheticChain{
+		config:            config,
+		minerPubkey:       minerPubkey,
+		miner:             aelf.AddressFromPublicKey(minerPubkey),
+		consensusContract: address(),
+		tokenContract:     address(),
+		appContract:       address(),
+	}
+	if multiToken := block.SystemContractsOf(config.chainId).MultiToken; multiToken != "" {
+		if tokenContract, err := aelf.AddressFromBase58(multiToken); err == nil {
+			chain.tokenContract = tokenContract
+		}
+	}
+	for i := 0; i < 8; i++ {
+		chain.users = append(chain.users, address())
+	}
+	return chain
+}
+
+// blocks returns the blocks produced at height, the forked block first when there is one.
+func (c *syntheticChain) blocks(height uint64, at time.Time) ([]*pbbstream.Block, error) {
+	var blocks []*pbbstream.Block
+	if c.config.forkEvery != 0 && height > c.config.firstBlock && height%c.config.forkEvery == 0 {
+		forked, err := c.block(height, true, at)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, forked)
+	}
+
+	canonical, err := c.block(height, false, at)
+	if err != nil {
+		return nil, err
+	}
+	return append(blocks, canonical), nil
+}
+
+// blockId returns the id of the canonical, or forked, block at height.
+func (c *syntheticChain) blockId(height uint64, forked bool) []byte {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("synthetic-aelf/%d/%d/%d/%t", c.config.chainId, c.config.seed, height, forked)))
+	return hash[:]
+}
+
+func (c *syntheticChain) block(height uint64, forked bool, at time.Time) (*pbbstream.Block, error) {
+	seed := c.config.seed ^ int64(height)<<1
+	if forked {
+		seed ^= 1
+	}
+	random := rand.New(rand.NewSource(seed))
+	randomHash := func() *aelf.Hash {
+		value := make([]byte, 32)
+		random.Read(value)
+		return &aelf.Hash{Value: value}
+	}
+
+	id, parentId := c.blockId(height, forked), c.blockId(height-1, false)
+	signature := make([]byte, 65)
+	random.Read(signature)
+
+	aelfBlock := &aelf.Block{
+		Header: &aelf.BlockHeader{
+			ChainId:                           c.config.chainId,
+			PreviousBlockHash:                 &aelf.Hash{Value: parentId},
+			MerkleTreeRootOfTransactions:      randomHash(),
+			MerkleTreeRootOfWorldState:        randomHash(),
+			MerkleTreeRootOfTransactionStatus: randomHash(),
+			Height:                            int64(height),
+			Time:                              timestamppb.New(at),
+			SignerPubkey:                      c.minerPubkey,
+			Signature:                         signature,
+		},
+		Body:         &aelf.BlockBody{},
+		FirehoseBody: &aelf.FirehoseBlockBody{},
+	}
+	libNum := c.config.firstBlock
+	if height > c.config.firstBlock+c.config.libDepth {
+		libNum = height - c.config.libDepth
+	}
+
+	systemTransactionCount, err := proto.Marshal(&wrapperspb.Int32Value{Value: 1})
+	if err != nil {
+		return nil, err
+	}
+	consensus, err := proto.Marshal(c.consensusInformation(height, libNum, at, randomHash()))
+	if err != nil {
+		return nil, err
+	}
+	aelfBlock.Header.ExtraData = map[string][]byte{"SystemTransactionCount": systemTransactionCount, "Consensus": consensus}
+
+	updateValue := c.transaction(random, c.miner, c.consensusContract, "UpdateValue", randomHash())
+	updateValueTrace := c.trace(aelf.ExecutionStatus_EXECUTED, &aelf.LogEvent{Address: c.consensusContract, Name: "MiningInformationUpdated"})
+	if err := appendTransaction(aelfBlock, updateValue, updateValueTrace); err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < c.config.transactionsPerBlock; i++ {
+		tx, trace, err := c.purchase(random)
+		if err != nil {
+			return nil, err
+		}
+		if err := appendTransaction(aelfBlock, tx, trace); err != nil {
+			return nil, err
+		}
+	}
+
+	payload, err := proto.Marshal(aelfBlock)
+	if err != nil {
+		return nil, fmt.Errorf("marshal block #%d: %w", height, err)
+	}
+
+	return &pbbstream.Block{
+		Number:    height,
+		Id:        hex.EncodeToString(id),
+		ParentNum: height - 1,
+		ParentId:  hex.EncodeToString(parentId),
+		LibNum:    libNum,
+		Timestamp: timestamppb.New(at),
+		Payload:   &anypb.Any{TypeUrl: aelfBlockTypeUrl, Value: payload},
+	}, nil
+}
+
+// consensusInformation returns the Consensus extra data of the block at height, the UpdateValue of the single
+// miner of the chain, whose round is the block height.
+func (c *syntheticChain) consensusInformation(height, libNum uint64, at time.Time, outValue *aelf.Hash) *aelf.AElfConsensusHeaderInformation {
+	pubkey := hex.EncodeToString(c.minerPubkey)
+	return &aelf.AElfConsensusHeaderInformation{
+		SenderPubkey: c.minerPubkey,
+		Behaviour:    aelf.AElfConsensusBehaviour_UPDATE_VALUE,
+		Round: &aelf.Round{
+			RoundNumber: int64(height),
+			TermNumber:  1,
+			RealTimeMinersInformation: map[string]*aelf.MinerInRound{
+				pubkey: {
+					Pubkey:               pubkey,
+					Order:                1,
+					IsExtraBlockProducer: true,
+					OutValue:             outValue,
+					ExpectedMiningTime:   timestamppb.New(at),
+					ActualMiningTimes:    []*timestamppb.Timestamp{timestamppb.New(at)},
+					ProducedBlocks:       int64(height),
+				},
+			},
+			ConfirmedIrreversibleBlockHeight:      int64(libNum),
+			ConfirmedIrreversibleBlockRoundNumber: int64(libNum),
+		},
+	}
+}
+
+// purchase returns a user purchase: the application contract transfers the price from the user with an
+// inline call. A failing purchase still charges its fee, its inline transfer is reverted.
+func (c *syntheticChain) purchase(random *rand.Rand) (*aelf.Transaction, *aelf.TransactionTrace, error) {
+	user := c.users[random.Intn(len(c.users))]
+	price := random.Int63n(1_000_000_000) + 1
+	failing := random.Float64() < c.config.failingTransactions
+
+	feeCharged, err := c.event(c.tokenContract, "TransactionFeeCharged", nil, &aelf.TransactionFeeCharged{Symbol: "ELF", Amount: random.Int63n(5_000_000) + 1_000_000, ChargingAddress: user})
+	if err != nil {
+		return nil, nil, err
+	}
+	transferred, err := c.event(c.tokenContract, "Transferred",
+		[]proto.Message{&aelf.Transferred{From: user}, &aelf.Transferred{To: c.appContract}, &aelf.Transferred{Symbol: "ELF"}},
+		&aelf.Transferred{Amount: price})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transfer := c.trace(aelf.ExecutionStatus_EXECUTED, transferred)
+	transfer.StateSet.Writes = map[string][]byte{
+		fmt.Sprintf("%s/Balances/%s/ELF", c.tokenContract.ToBase58(), user.ToBase58()):          binary.AppendVarint(nil, -price),
+		fmt.Sprintf("%s/Balances/%s/ELF", c.tokenContract.ToBase58(), c.appContract.ToBase58()): binary.AppendVarint(nil, price),
+	}
+
+	trace := c.trace(aelf.ExecutionStatus_EXECUTED, &aelf.LogEvent{Address: c.appContract, Name: "Purchased"})
+	trace.PreTransactions = []*aelf.Transaction{c.transaction(random, user, c.tokenContract, "ChargeTransactionFees", &wrapperspb.StringValue{Value: "Purchase"})}
+	trace.PreTraces = []*aelf.TransactionTrace{c.trace(aelf.ExecutionStatus_EXECUTED, feeCharged)}
+	trace.InlineTransactions = []*aelf.Transaction{c.transaction(random, c.appContract, c.tokenContract, "TransferFrom", &aelf.TransferInput{To: c.appContract, Symbol: "ELF", Amount: price})}
+	trace.InlineTraces = []*aelf.TransactionTrace{transfer}
+	if failing {
+		trace.ExecutionStatus = aelf.ExecutionStatus_CONTRACT_ERROR
+		trace.Error = "AElf.Sdk.CSharp.AssertionException: Item sold out."
+		trace.Logs = nil
+	}
+
+	return c.transaction(random, user, c.appContract, "Purchase", &wrapperspb.Int64Value{Value: price}), trace, nil
+}
+
+func (c *syntheticChain) transaction(random *rand.Rand, from, to *aelf.Address, methodName string, params proto.Message) *aelf.Transaction {
+	data, err := proto.Marshal(params)
+	if err != nil {
+		panic(fmt.Errorf("marshal %s params: %w", methodName, err))
+	}
+	prefix, signature := make([]byte, 4), make([]byte, 65)
+	random.Read(prefix)
+	random.Read(signature)
+
+	return &aelf.Transaction{From: from, To: to, RefBlockPrefix: prefix, MethodName: methodName, Params: data, Signature: signature}
+}
+
+func (c *syntheticChain) trace(status aelf.ExecutionStatus, logs ...*aelf.LogEvent) *aelf.TransactionTrace {
+	return &aelf.TransactionTrace{ExecutionStatus: status, Logs: logs, StateSet: &aelf.TransactionExecutingStateSet{}}
+}
+
+// event builds a log event the way AElf does, each indexed field being serialized in its own message.
+func (c *syntheticChain) event(address *aelf.Address, name string, indexed []proto.Message, nonIndexed proto.Message) (*aelf.LogEvent, error) {
+	event := &aelf.LogEvent{Address: address, Name: name}
+	for _, message := range indexed {
+		data, err := proto.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+		event.Indexed = append(event.Indexed, data)
+	}
+
+	var err error
+	event.NonIndexed, err = proto.Marshal(nonIndexed)
+	return event, err
+}
+
+// appendTransaction adds tx, with its id, and its trace to the block.
+func appendTransaction(aelfBlock *aelf.Block, tx *aelf.Transaction, trace *aelf.TransactionTrace) error {
+	tx.RefBlockNumber = aelfBlock.Header.Height - 1
+	rawTransaction, err := proto.Marshal(tx)
+	if err != nil {
+		return fmt.Errorf("marshal transaction: %w", err)
+	}
+	txId, err := block.TransactionId(rawTransaction)
+	if err != nil {
+		return err
+	}
+
+	aelfBlock.Body.TransactionIds = append(aelfBlock.Body.TransactionIds, &aelf.Hash{Value: txId})
+	aelfBlock.FirehoseBody.Transactions = append(aelfBlock.FirehoseBody.Transactions, tx)
+	aelfBlock.FirehoseBody.TransactionTraces = append(aelfBlock.FirehoseBody.TransactionTraces, trace)
+	return nil
+}
+
+func writeFireInit(w io.Writer) error {
+	_, err := fmt.Fprintf(w, "FIRE INIT 3.0 %s\n", aelfBlockTypeUrl)
+	return err
+}
+
+// writeFireBlock writes the FIRE protocol line of blk, as read by the reader node.
+func writeFireBlock(w io.Writer, blk *pbbstream.Block) error {
+	_, err := fmt.Fprintf(w, "FIRE BLOCK %d %s %d %s %d %d %s\n",
+		blk.Number,
+		blk.Id,
+		blk.ParentNum,
+		blk.ParentId,
+		blk.LibNum,
+		blk.Timestamp.AsTime().UnixNano(),
+		base64.StdEncoding.EncodeToString(blk.Payload.Value),
+	)
+	return err
+}
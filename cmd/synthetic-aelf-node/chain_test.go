@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/pflag"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/firehose-aelf/block"
+	firecore "github.com/streamingfast/firehose-core"
+	"github.com/streamingfast/logging"
+	"github.com/test-go/testify/assert"
+	"github.com/test-go/testify/require"
+	"go.uber.org/zap"
+)
+
+var _, testTracer = logging.PackageLogger("synthetic-aelf-node-test", "github.com/streamingfast/firehose-aelf/cmd/synthetic-aelf-node/test")
+
+// runStartCmd runs the start command with args and returns the blocks it emitted, read as the reader node
+// reads them.
+func runStartCmd(t *testing.T, args ...string) []*pbbstream.Block {
+	t.Helper()
+
+	var output bytes.Buffer
+	rootCmd.SetArgs(append([]string{"start", "--block-rate=0"}, args...))
+	rootCmd.SetOut(&output)
+	rootCmd.SilenceUsage, rootCmd.SilenceErrors = true, true
+	t.Cleanup(func() {
+		rootCmd.SetOut(nil)
+		startCmd.Flags().VisitAll(func(flag *pflag.Flag) {
+			flag.Value.Set(flag.DefValue)
+			flag.Changed = false
+		})
+	})
+	require.NoError(t, rootCmd.ExecuteContext(context.Background()))
+
+	lines := make(chan string, 1024)
+	for _, line := range strings.Split(strings.TrimSpace(output.String()), "\n") {
+		lines <- line
+	}
+	close(lines)
+
+	reader, err := firecore.NewConsoleReader(lines, nil, zap.NewNop(), testTracer)
+	require.NoError(t, err)
+
+	var blocks []*pbbstream.Block
+	for {
+		blk, err := reader.ReadBlock()
+		if err == io.EOF {
+			return blocks
+		}
+		require.NoError(t, err)
+		blocks = append(blocks, blk)
+	}
+}
+
+func TestStartCmd(t *testing.T) {
+	blocks := runStartCmd(t, "--start-block=10", "--stop-block=25", "--fork-every=5", "--lib-depth=3")
+
+	var canonical []*pbbstream.Block
+	for i, blk := range blocks {
+		converted, err := block.ConvertRawBlock(blk.Id, blk.Payload.Value, block.LatestVersion)
+		require.NoError(t, err, "block #%d (%s)", blk.Number, blk.Id)
+		assert.Equal(t, int64(blk.Number), converted.Height)
+		assert.Equal(t, blk.ParentId, converted.Header.PreviousBlockHash)
+		assert.Len(t, converted.TransactionTraces, 6)
+		require.NotNil(t, converted.Header.Consensus, "block #%d has consensus extra data", blk.Number)
+		assert.Equal(t, int64(blk.Number), converted.Header.Consensus.RoundNumber)
+		assert.Equal(t, int64(blk.LibNum), converted.Header.Consensus.ConfirmedIrreversibleBlockHeight)
+		require.Len(t, converted.Header.Consensus.Miners, 1)
+		assert.Equal(t, converted.Header.Consensus.SenderPubkey, converted.Header.Consensus.ExtraBlockProducer)
+		assert.NotEmpty(t, converted.Stats.FeesBySymbol["ELF"], "the fees of the MultiToken contract are counted")
+
+		if i+1 < len(blocks) && blocks[i+1].Number == blk.Number {
+			// The forked block is emitted first, sibling of the canonical block that follows
+			assert.Equal(t, uint64(0), blk.Number%5, "block #%d is forked", blk.Number)
+			assert.Equal(t, blk.ParentId, blocks[i+1].ParentId)
+			assert.NotEqual(t, blk.Id, blocks[i+1].Id)
+			continue
+		}
+		canonical = append(canonical, blk)
+	}
+
+	require.Len(t, canonical, 16)
+	for i, blk := range canonical {
+		assert.Equal(t, uint64(10+i), blk.Number)
+		if i > 0 {
+			assert.Equal(t, canonical[i-1].Id, blk.ParentId, "block #%d extends the canonical chain", blk.Number)
+		}
+		assert.Equal(t, max(10, blk.Number-3), blk.LibNum)
+	}
+	assert.Len(t, blocks, 19)
+}
+
+func TestStartCmd_FailingTransactions(t *testing.T) {
+	blocks := runStartCmd(t, "--stop-block=3", "--transactions-per-block=4", "--failing-transactions=1")
+	require.Len(t, blocks, 3)
+
+	for _, blk := range blocks {
+		converted, err := block.ConvertRawBlock(blk.Id, blk.Payload.Value, block.LatestVersion)
+		require.NoError(t, err)
+		assert.Equal(t, int32(4), converted.Stats.FailedTransactionCount)
+		assert.Equal(t, int32(1), converted.Stats.SystemTransactionCount)
+		for _, trace := range converted.TransactionTraces[1:] {
+			for _, call := range trace.Calls {
+				assert.Equal(t, call.MethodName != "ChargeTransactionFees", call.IsReverted, "call %s", call.CallPath)
+			}
+		}
+	}
+}
+
+func TestStartCmd_UnknownChain(t *testing.T) {
+	blocks := runStartCmd(t, "--stop-block=2", "--chain-id=42")
+	require.Len(t, blocks, 2)
+
+	for _, blk := range blocks {
+		converted, err := block.ConvertRawBlock(blk.Id, blk.Payload.Value, block.LatestVersion)
+		require.NoError(t, err)
+		assert.Equal(t, int32(42), converted.Header.ChainId)
+		assert.NotNil(t, converted.Header.Consensus)
+		assert.Empty(t, converted.Stats.FeesBySymbol, "the token contract of an unknown chain is random")
+		assert.Nil(t, converted.CrossChain)
+	}
+}
+
+func TestStartCmd_Resume(t *testing.T) {
+	storeDir := t.TempDir()
+
+	first := runStartCmd(t, "--stop-block=4", "--store-dir="+storeDir)
+	head, err := os.ReadFile(filepath.Join(storeDir, "head"))
+	require.NoError(t, err)
+	assert.Equal(t, "4", string(head))
+
+	resumed := runStartCmd(t, "--stop-block=6", "--store-dir="+storeDir)
+	require.Len(t, resumed, 2)
+	assert.Equal(t, uint64(5), resumed[0].Number)
+	assert.Equal(t, first[3].Id, resumed[0].ParentId)
+}
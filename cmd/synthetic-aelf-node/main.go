@@ -0,0 +1,183 @@
+// Command synthetic-aelf-node emits the FIRE protocol lines of a synthetic AElf chain on its standard output,
+// as an instrumented AElf node does, to run `fireaelf start` end to end locally without a .NET node.
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "synthetic-aelf-node",
+	Short: "Synthetic AElf node emitting FIRE protocol blocks",
+}
+
+var startCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Start producing blocks",
+	Args:  cobra.NoArgs,
+	RunE:  runStart,
+}
+
+func init() {
+	startCmd.Flags().Int("block-rate", 60, "Number of blocks produced per minute, 0 producing them as fast as possible")
+	startCmd.Flags().Uint64("start-block", 1, "Height of the first block, ignored when resuming from the store directory")
+	startCmd.Flags().Uint64("stop-block", 0, "Height of the last block produced before exiting, 0 producing blocks forever")
+	startCmd.Flags().String("store-dir", "", "Directory keeping the height of the last block produced, to resume from it on restart")
+	startCmd.Flags().Int("transactions-per-block", 5, "Number of user transactions in each block, along with the consensus transaction")
+	startCmd.Flags().Float64("failing-transactions", 0.1, "Ratio, between 0 and 1, of the user transactions failing")
+	startCmd.Flags().Uint64("fork-every", 0, "Produce a forked block, sibling of the canonical one, every N blocks, 0 disabling forks")
+	startCmd.Flags().Uint64("lib-depth", 8, "Number of blocks between the head block and the last irreversible block")
+	startCmd.Flags().Int32("chain-id", 9992731, "Chain id of the blocks, 9992731 being the AELF main chain, the tokens being those of its MultiToken contract, other chains without known system contracts having no fees in Block.stats")
+	startCmd.Flags().Int64("seed", 1, "Seed of the generated chain, the same seed producing the same blocks")
+
+	rootCmd.AddCommand(startCmd)
+}
+
+func main() {
+	log.SetOutput(os.Stderr)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := rootCmd.ExecuteContext(ctx); err != nil {
+		os.Exit(1)
+	}
+}
+
+func runStart(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	blockRate, _ := flags.GetInt("block-rate")
+	startBlock, _ := flags.GetUint64("start-block")
+	stopBlock, _ := flags.GetUint64("stop-block")
+	storeDir, _ := flags.GetString("store-dir")
+	failingTransactions, _ := flags.GetFloat64("failing-transactions")
+
+	config := chainConfig{firstBlock: startBlock, failingTransactions: failingTransactions}
+	config.transactionsPerBlock, _ = flags.GetInt("transactions-per-block")
+	config.forkEvery, _ = flags.GetUint64("fork-every")
+	config.libDepth, _ = flags.GetUint64("lib-depth")
+	config.chainId, _ = flags.GetInt32("chain-id")
+	config.seed, _ = flags.GetInt64("seed")
+
+	if startBlock == 0 {
+		return fmt.Errorf("invalid start block 0, the parent of the first block must exist")
+	}
+	if blockRate < 0 {
+		return fmt.Errorf("invalid block rate %d, must be positive", blockRate)
+	}
+	if failingTransactions < 0 || failingTransactions > 1 {
+		return fmt.Errorf("invalid failing transactions ratio %v, must be between 0 and 1", failingTransactions)
+	}
+
+	height := startBlock
+	if storeDir != "" {
+		head, err := readHead(storeDir)
+		if err != nil {
+			return err
+		}
+		if head != 0 {
+			log.Printf("resuming after block #%d", head)
+			height = head + 1
+		}
+	}
+
+	var interval time.Duration
+	if blockRate > 0 {
+		interval = time.Minute / time.Duration(blockRate)
+	}
+
+	output := bufio.NewWriter(cmd.OutOrStdout())
+	return produce(cmd.Context(), newSyntheticChain(config), output, height, stopBlock, interval, storeDir)
+}
+
+// produce writes the blocks from height up to stopBlock, or until ctx is done, one height every interval.
+func produce(ctx context.Context, chain *syntheticChain, output *bufio.Writer, height, stopBlock uint64, interval time.Duration, storeDir string) error {
+	if err := writeFireInit(output); err != nil {
+		return err
+	}
+
+	var ticker *time.Ticker
+	if interval > 0 {
+		ticker = time.NewTicker(interval)
+		defer ticker.Stop()
+	}
+
+	for ; stopBlock == 0 || height <= stopBlock; height++ {
+		blocks, err := chain.blocks(height, time.Now())
+		if err != nil {
+			return fmt.Errorf("unable to produce block #%d: %w", height, err)
+		}
+		for _, blk := range blocks {
+			if err := writeFireBlock(output, blk); err != nil {
+				return err
+			}
+		}
+		if err := output.Flush(); err != nil {
+			return fmt.Errorf("unable to write block #%d: %w", height, err)
+		}
+		if storeDir != "" {
+			if err := writeHead(storeDir, height); err != nil {
+				return err
+			}
+		}
+
+		if ticker == nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+
+	log.Printf("stop block #%d reached", stopBlock)
+	return nil
+}
+
+const headFilename = "head"
+
+// readHead returns the height of the last block produced recorded in storeDir, 0 when there is none.
+func readHead(storeDir string) (uint64, error) {
+	data, err := os.ReadFile(filepath.Join(storeDir, headFilename))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("unable to read head: %w", err)
+	}
+
+	head, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid head file: %w", err)
+	}
+	return head, nil
+}
+
+func writeHead(storeDir string, height uint64) error {
+	if err := os.MkdirAll(storeDir, 0755); err != nil {
+		return fmt.Errorf("unable to create store directory: %w", err)
+	}
+
+	temp := filepath.Join(storeDir, headFilename+".tmp")
+	if err := os.WriteFile(temp, []byte(strconv.FormatUint(height, 10)), 0644); err != nil {
+		return fmt.Errorf("unable to write head: %w", err)
+	}
+	return os.Rename(temp, filepath.Join(storeDir, headFilename))
+}
@@ -0,0 +1,49 @@
+#!/usr/bin/env bash
+
+ROOT="$( cd "$( dirname "${BASH_SOURCE[0]}" )" && pwd )"
+
+clean=
+fireaelf="${FIREAELF:-fireaelf}"
+
+main() {
+  pushd "$ROOT" &> /dev/null
+
+  while getopts "hc" opt; do
+    case $opt in
+      h) usage && exit 0;;
+      c) clean=true;;
+      \?) usage_error "Invalid option: -$OPTARG";;
+    esac
+  done
+  shift $((OPTIND-1))
+  [[ $1 = "--" ]] && shift
+
+  set -e
+
+  if [[ $clean == "true" ]]; then
+    rm -rf firehose-data &> /dev/null || true
+  fi
+
+  exec $fireaelf -c $(basename $ROOT).yaml start "$@"
+}
+
+usage_error() {
+  message="$1"
+  exit_code="$2"
+
+  echo "ERROR: $message"
+  echo ""
+  usage
+  exit ${exit_code:-1}
+}
+
+usage() {
+  echo "usage: start.sh [-c]"
+  echo ""
+  echo "Start $(basename $ROOT) environment."
+  echo ""
+  echo "Options"
+  echo "    -c             Clean actual data directory first"
+}
+
+main "$@"
\ No newline at end of file
@@ -0,0 +1,578 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.20.3
+// source: aelf/acs7.proto
+
+package aelf
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CrossChainBlockData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The side chain block data.
+	SideChainBlockDataList []*SideChainBlockData `protobuf:"bytes,1,rep,name=side_chain_block_data_list,json=sideChainBlockDataList,proto3" json:"side_chain_block_data_list,omitempty"`
+	// The parent chain block data.
+	ParentChainBlockDataList []*ParentChainBlockData `protobuf:"bytes,2,rep,name=parent_chain_block_data_list,json=parentChainBlockDataList,proto3" json:"parent_chain_block_data_list,omitempty"`
+}
+
+func (x *CrossChainBlockData) Reset() {
+	*x = CrossChainBlockData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aelf_acs7_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrossChainBlockData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrossChainBlockData) ProtoMessage() {}
+
+func (x *CrossChainBlockData) ProtoReflect() protoreflect.Message {
+	mi := &file_aelf_acs7_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrossChainBlockData.ProtoReflect.Descriptor instead.
+func (*CrossChainBlockData) Descriptor() ([]byte, []int) {
+	return file_aelf_acs7_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CrossChainBlockData) GetSideChainBlockDataList() []*SideChainBlockData {
+	if x != nil {
+		return x.SideChainBlockDataList
+	}
+	return nil
+}
+
+func (x *CrossChainBlockData) GetParentChainBlockDataList() []*ParentChainBlockData {
+	if x != nil {
+		return x.ParentChainBlockDataList
+	}
+	return nil
+}
+
+type SideChainBlockData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The height of side chain block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// The hash of side chain block.
+	BlockHeaderHash *Hash `protobuf:"bytes,2,opt,name=block_header_hash,json=blockHeaderHash,proto3" json:"block_header_hash,omitempty"`
+	// The merkle tree root computing from transactions status in side chain block.
+	TransactionStatusMerkleTreeRoot *Hash `protobuf:"bytes,3,opt,name=transaction_status_merkle_tree_root,json=transactionStatusMerkleTreeRoot,proto3" json:"transaction_status_merkle_tree_root,omitempty"`
+	// The id of side chain.
+	ChainId int32 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (x *SideChainBlockData) Reset() {
+	*x = SideChainBlockData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aelf_acs7_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SideChainBlockData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SideChainBlockData) ProtoMessage() {}
+
+func (x *SideChainBlockData) ProtoReflect() protoreflect.Message {
+	mi := &file_aelf_acs7_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SideChainBlockData.ProtoReflect.Descriptor instead.
+func (*SideChainBlockData) Descriptor() ([]byte, []int) {
+	return file_aelf_acs7_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SideChainBlockData) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *SideChainBlockData) GetBlockHeaderHash() *Hash {
+	if x != nil {
+		return x.BlockHeaderHash
+	}
+	return nil
+}
+
+func (x *SideChainBlockData) GetTransactionStatusMerkleTreeRoot() *Hash {
+	if x != nil {
+		return x.TransactionStatusMerkleTreeRoot
+	}
+	return nil
+}
+
+func (x *SideChainBlockData) GetChainId() int32 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+type ParentChainBlockData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The height of parent chain.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// The merkle tree root computing from side chain roots.
+	CrossChainExtraData *CrossChainExtraData `protobuf:"bytes,2,opt,name=cross_chain_extra_data,json=crossChainExtraData,proto3" json:"cross_chain_extra_data,omitempty"`
+	// The parent chain id.
+	ChainId int32 `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// The merkle tree root computing from transactions status in parent chain block.
+	TransactionStatusMerkleTreeRoot *Hash `protobuf:"bytes,4,opt,name=transaction_status_merkle_tree_root,json=transactionStatusMerkleTreeRoot,proto3" json:"transaction_status_merkle_tree_root,omitempty"`
+	// Indexed block height from side chain and merkle path for this side chain block
+	IndexedMerklePath map[int64]*MerklePath `protobuf:"bytes,5,rep,name=indexed_merkle_path,json=indexedMerklePath,proto3" json:"indexed_merkle_path,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Extra data map.
+	ExtraData map[string][]byte `protobuf:"bytes,6,rep,name=extra_data,json=extraData,proto3" json:"extra_data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ParentChainBlockData) Reset() {
+	*x = ParentChainBlockData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aelf_acs7_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParentChainBlockData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParentChainBlockData) ProtoMessage() {}
+
+func (x *ParentChainBlockData) ProtoReflect() protoreflect.Message {
+	mi := &file_aelf_acs7_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParentChainBlockData.ProtoReflect.Descriptor instead.
+func (*ParentChainBlockData) Descriptor() ([]byte, []int) {
+	return file_aelf_acs7_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ParentChainBlockData) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ParentChainBlockData) GetCrossChainExtraData() *CrossChainExtraData {
+	if x != nil {
+		return x.CrossChainExtraData
+	}
+	return nil
+}
+
+func (x *ParentChainBlockData) GetChainId() int32 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *ParentChainBlockData) GetTransactionStatusMerkleTreeRoot() *Hash {
+	if x != nil {
+		return x.TransactionStatusMerkleTreeRoot
+	}
+	return nil
+}
+
+func (x *ParentChainBlockData) GetIndexedMerklePath() map[int64]*MerklePath {
+	if x != nil {
+		return x.IndexedMerklePath
+	}
+	return nil
+}
+
+func (x *ParentChainBlockData) GetExtraData() map[string][]byte {
+	if x != nil {
+		return x.ExtraData
+	}
+	return nil
+}
+
+type CrossChainExtraData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Merkle tree root of side chain block transaction status root.
+	TransactionStatusMerkleTreeRoot *Hash `protobuf:"bytes,1,opt,name=transaction_status_merkle_tree_root,json=transactionStatusMerkleTreeRoot,proto3" json:"transaction_status_merkle_tree_root,omitempty"`
+}
+
+func (x *CrossChainExtraData) Reset() {
+	*x = CrossChainExtraData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aelf_acs7_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrossChainExtraData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrossChainExtraData) ProtoMessage() {}
+
+func (x *CrossChainExtraData) ProtoReflect() protoreflect.Message {
+	mi := &file_aelf_acs7_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrossChainExtraData.ProtoReflect.Descriptor instead.
+func (*CrossChainExtraData) Descriptor() ([]byte, []int) {
+	return file_aelf_acs7_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CrossChainExtraData) GetTransactionStatusMerkleTreeRoot() *Hash {
+	if x != nil {
+		return x.TransactionStatusMerkleTreeRoot
+	}
+	return nil
+}
+
+// The input of the `RecordCrossChainData` method of the CrossChain contract (`cross_chain_contract.proto` in
+// AElf), called by the parliament when an approved indexing proposal is released.
+type RecordCrossChainDataInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The cross chain data to index.
+	ProposedCrossChainData *CrossChainBlockData `protobuf:"bytes,1,opt,name=proposed_cross_chain_data,json=proposedCrossChainData,proto3" json:"proposed_cross_chain_data,omitempty"`
+	// The proposer of the cross chain data.
+	Proposer *Address `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+}
+
+func (x *RecordCrossChainDataInput) Reset() {
+	*x = RecordCrossChainDataInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aelf_acs7_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordCrossChainDataInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordCrossChainDataInput) ProtoMessage() {}
+
+func (x *RecordCrossChainDataInput) ProtoReflect() protoreflect.Message {
+	mi := &file_aelf_acs7_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordCrossChainDataInput.ProtoReflect.Descriptor instead.
+func (*RecordCrossChainDataInput) Descriptor() ([]byte, []int) {
+	return file_aelf_acs7_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RecordCrossChainDataInput) GetProposedCrossChainData() *CrossChainBlockData {
+	if x != nil {
+		return x.ProposedCrossChainData
+	}
+	return nil
+}
+
+func (x *RecordCrossChainDataInput) GetProposer() *Address {
+	if x != nil {
+		return x.Proposer
+	}
+	return nil
+}
+
+var File_aelf_acs7_proto protoreflect.FileDescriptor
+
+var file_aelf_acs7_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x61, 0x65, 0x6c, 0x66, 0x2f, 0x61, 0x63, 0x73, 0x37, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x04, 0x61, 0x65, 0x6c, 0x66, 0x1a, 0x0f, 0x61, 0x65, 0x6c, 0x66, 0x2f, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc7, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x6f,
+	0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x54, 0x0a, 0x1a, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x53, 0x69, 0x64, 0x65,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x52, 0x16,
+	0x73, 0x69, 0x64, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x61,
+	0x74, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5a, 0x0a, 0x1c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61,
+	0x65, 0x6c, 0x66, 0x2e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x52, 0x18, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x4c, 0x69,
+	0x73, 0x74, 0x22, 0xd9, 0x01, 0x0a, 0x12, 0x53, 0x69, 0x64, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x36, 0x0a, 0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61,
+	0x65, 0x6c, 0x66, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x58, 0x0a, 0x23, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
+	0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x48, 0x61,
+	0x73, 0x68, 0x52, 0x1f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52,
+	0x6f, 0x6f, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0xb6,
+	0x04, 0x0a, 0x14, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x4e, 0x0a, 0x16, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x65,
+	0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x13, 0x63, 0x72, 0x6f, 0x73,
+	0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x58, 0x0a, 0x23, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x48,
+	0x61, 0x73, 0x68, 0x52, 0x1f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x54, 0x72, 0x65, 0x65,
+	0x52, 0x6f, 0x6f, 0x74, 0x12, 0x61, 0x0a, 0x13, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x5f,
+	0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x31, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x64, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x4d, 0x65, 0x72,
+	0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x48, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x65,
+	0x6c, 0x66, 0x2e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74,
+	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74,
+	0x61, 0x1a, 0x56, 0x0a, 0x16, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x4d, 0x65, 0x72, 0x6b,
+	0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61,
+	0x65, 0x6c, 0x66, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x45, 0x78, 0x74,
+	0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6f, 0x0a, 0x13, 0x43, 0x72, 0x6f, 0x73, 0x73,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x58,
+	0x0a, 0x23, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x65, 0x65,
+	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x65,
+	0x6c, 0x66, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x1f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65,
+	0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x9c, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74,
+	0x61, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x54, 0x0a, 0x19, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x64, 0x5f, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x65, 0x6c, 0x66,
+	0x2e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x16, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x43, 0x72,
+	0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x42, 0x48, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x66,
+	0x61, 0x73, 0x74, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x68, 0x6f, 0x73, 0x65, 0x2d, 0x61, 0x65, 0x6c,
+	0x66, 0x2f, 0x70, 0x62, 0x2f, 0x61, 0x65, 0x6c, 0x66, 0x3b, 0x61, 0x65, 0x6c, 0x66, 0xaa, 0x02,
+	0x10, 0x41, 0x45, 0x6c, 0x66, 0x2e, 0x46, 0x69, 0x72, 0x65, 0x68, 0x6f, 0x73, 0x65, 0x2e, 0x50,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_aelf_acs7_proto_rawDescOnce sync.Once
+	file_aelf_acs7_proto_rawDescData = file_aelf_acs7_proto_rawDesc
+)
+
+func file_aelf_acs7_proto_rawDescGZIP() []byte {
+	file_aelf_acs7_proto_rawDescOnce.Do(func() {
+		file_aelf_acs7_proto_rawDescData = protoimpl.X.CompressGZIP(file_aelf_acs7_proto_rawDescData)
+	})
+	return file_aelf_acs7_proto_rawDescData
+}
+
+var file_aelf_acs7_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_aelf_acs7_proto_goTypes = []any{
+	(*CrossChainBlockData)(nil),       // 0: aelf.CrossChainBlockData
+	(*SideChainBlockData)(nil),        // 1: aelf.SideChainBlockData
+	(*ParentChainBlockData)(nil),      // 2: aelf.ParentChainBlockData
+	(*CrossChainExtraData)(nil),       // 3: aelf.CrossChainExtraData
+	(*RecordCrossChainDataInput)(nil), // 4: aelf.RecordCrossChainDataInput
+	nil,                               // 5: aelf.ParentChainBlockData.IndexedMerklePathEntry
+	nil,                               // 6: aelf.ParentChainBlockData.ExtraDataEntry
+	(*Hash)(nil),                      // 7: aelf.Hash
+	(*Address)(nil),                   // 8: aelf.Address
+	(*MerklePath)(nil),                // 9: aelf.MerklePath
+}
+var file_aelf_acs7_proto_depIdxs = []int32{
+	1,  // 0: aelf.CrossChainBlockData.side_chain_block_data_list:type_name -> aelf.SideChainBlockData
+	2,  // 1: aelf.CrossChainBlockData.parent_chain_block_data_list:type_name -> aelf.ParentChainBlockData
+	7,  // 2: aelf.SideChainBlockData.block_header_hash:type_name -> aelf.Hash
+	7,  // 3: aelf.SideChainBlockData.transaction_status_merkle_tree_root:type_name -> aelf.Hash
+	3,  // 4: aelf.ParentChainBlockData.cross_chain_extra_data:type_name -> aelf.CrossChainExtraData
+	7,  // 5: aelf.ParentChainBlockData.transaction_status_merkle_tree_root:type_name -> aelf.Hash
+	5,  // 6: aelf.ParentChainBlockData.indexed_merkle_path:type_name -> aelf.ParentChainBlockData.IndexedMerklePathEntry
+	6,  // 7: aelf.ParentChainBlockData.extra_data:type_name -> aelf.ParentChainBlockData.ExtraDataEntry
+	7,  // 8: aelf.CrossChainExtraData.transaction_status_merkle_tree_root:type_name -> aelf.Hash
+	0,  // 9: aelf.RecordCrossChainDataInput.proposed_cross_chain_data:type_name -> aelf.CrossChainBlockData
+	8,  // 10: aelf.RecordCrossChainDataInput.proposer:type_name -> aelf.Address
+	9,  // 11: aelf.ParentChainBlockData.IndexedMerklePathEntry.value:type_name -> aelf.MerklePath
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_aelf_acs7_proto_init() }
+func file_aelf_acs7_proto_init() {
+	if File_aelf_acs7_proto != nil {
+		return
+	}
+	file_aelf_core_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_aelf_acs7_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*CrossChainBlockData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aelf_acs7_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*SideChainBlockData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aelf_acs7_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*ParentChainBlockData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aelf_acs7_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*CrossChainExtraData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aelf_acs7_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*RecordCrossChainDataInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_aelf_acs7_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_aelf_acs7_proto_goTypes,
+		DependencyIndexes: file_aelf_acs7_proto_depIdxs,
+		MessageInfos:      file_aelf_acs7_proto_msgTypes,
+	}.Build()
+	File_aelf_acs7_proto = out.File
+	file_aelf_acs7_proto_rawDesc = nil
+	file_aelf_acs7_proto_goTypes = nil
+	file_aelf_acs7_proto_depIdxs = nil
+}
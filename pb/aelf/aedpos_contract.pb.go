@@ -0,0 +1,902 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.20.3
+// source: aelf/aedpos_contract.proto
+
+package aelf
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AElfConsensusBehaviour int32
+
+const (
+	AElfConsensusBehaviour_UPDATE_VALUE AElfConsensusBehaviour = 0
+	AElfConsensusBehaviour_NEXT_ROUND   AElfConsensusBehaviour = 1
+	AElfConsensusBehaviour_NEXT_TERM    AElfConsensusBehaviour = 2
+	AElfConsensusBehaviour_NOTHING      AElfConsensusBehaviour = 3
+	AElfConsensusBehaviour_TINY_BLOCK   AElfConsensusBehaviour = 4
+)
+
+// Enum value maps for AElfConsensusBehaviour.
+var (
+	AElfConsensusBehaviour_name = map[int32]string{
+		0: "UPDATE_VALUE",
+		1: "NEXT_ROUND",
+		2: "NEXT_TERM",
+		3: "NOTHING",
+		4: "TINY_BLOCK",
+	}
+	AElfConsensusBehaviour_value = map[string]int32{
+		"UPDATE_VALUE": 0,
+		"NEXT_ROUND":   1,
+		"NEXT_TERM":    2,
+		"NOTHING":      3,
+		"TINY_BLOCK":   4,
+	}
+)
+
+func (x AElfConsensusBehaviour) Enum() *AElfConsensusBehaviour {
+	p := new(AElfConsensusBehaviour)
+	*p = x
+	return p
+}
+
+func (x AElfConsensusBehaviour) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AElfConsensusBehaviour) Descriptor() protoreflect.EnumDescriptor {
+	return file_aelf_aedpos_contract_proto_enumTypes[0].Descriptor()
+}
+
+func (AElfConsensusBehaviour) Type() protoreflect.EnumType {
+	return &file_aelf_aedpos_contract_proto_enumTypes[0]
+}
+
+func (x AElfConsensusBehaviour) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AElfConsensusBehaviour.Descriptor instead.
+func (AElfConsensusBehaviour) EnumDescriptor() ([]byte, []int) {
+	return file_aelf_aedpos_contract_proto_rawDescGZIP(), []int{0}
+}
+
+type AElfConsensusHeaderInformation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The sender public key.
+	SenderPubkey []byte `protobuf:"bytes,1,opt,name=sender_pubkey,json=senderPubkey,proto3" json:"sender_pubkey,omitempty"`
+	// The round information.
+	Round *Round `protobuf:"bytes,2,opt,name=round,proto3" json:"round,omitempty"`
+	// The behaviour of consensus.
+	Behaviour AElfConsensusBehaviour `protobuf:"varint,3,opt,name=behaviour,proto3,enum=aelf.AElfConsensusBehaviour" json:"behaviour,omitempty"`
+}
+
+func (x *AElfConsensusHeaderInformation) Reset() {
+	*x = AElfConsensusHeaderInformation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aelf_aedpos_contract_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AElfConsensusHeaderInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AElfConsensusHeaderInformation) ProtoMessage() {}
+
+func (x *AElfConsensusHeaderInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_aelf_aedpos_contract_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AElfConsensusHeaderInformation.ProtoReflect.Descriptor instead.
+func (*AElfConsensusHeaderInformation) Descriptor() ([]byte, []int) {
+	return file_aelf_aedpos_contract_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AElfConsensusHeaderInformation) GetSenderPubkey() []byte {
+	if x != nil {
+		return x.SenderPubkey
+	}
+	return nil
+}
+
+func (x *AElfConsensusHeaderInformation) GetRound() *Round {
+	if x != nil {
+		return x.Round
+	}
+	return nil
+}
+
+func (x *AElfConsensusHeaderInformation) GetBehaviour() AElfConsensusBehaviour {
+	if x != nil {
+		return x.Behaviour
+	}
+	return AElfConsensusBehaviour_UPDATE_VALUE
+}
+
+type Round struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The round number.
+	RoundNumber int64 `protobuf:"varint,1,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
+	// Current miner information, miner public key (hex string) -> miner information.
+	RealTimeMinersInformation map[string]*MinerInRound `protobuf:"bytes,2,rep,name=real_time_miners_information,json=realTimeMinersInformation,proto3" json:"real_time_miners_information,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The round number on the main chain
+	MainChainMinersRoundNumber int64 `protobuf:"varint,3,opt,name=main_chain_miners_round_number,json=mainChainMinersRoundNumber,proto3" json:"main_chain_miners_round_number,omitempty"`
+	// The time from chain start to current round (seconds).
+	BlockchainAge int64 `protobuf:"varint,4,opt,name=blockchain_age,json=blockchainAge,proto3" json:"blockchain_age,omitempty"`
+	// The miner public key that produced the extra block in the previous round.
+	ExtraBlockProducerOfPreviousRound string `protobuf:"bytes,5,opt,name=extra_block_producer_of_previous_round,json=extraBlockProducerOfPreviousRound,proto3" json:"extra_block_producer_of_previous_round,omitempty"`
+	// The current term number.
+	TermNumber int64 `protobuf:"varint,6,opt,name=term_number,json=termNumber,proto3" json:"term_number,omitempty"`
+	// The height of the confirmed irreversible block.
+	ConfirmedIrreversibleBlockHeight int64 `protobuf:"varint,7,opt,name=confirmed_irreversible_block_height,json=confirmedIrreversibleBlockHeight,proto3" json:"confirmed_irreversible_block_height,omitempty"`
+	// The round number of the confirmed irreversible block.
+	ConfirmedIrreversibleBlockRoundNumber int64 `protobuf:"varint,8,opt,name=confirmed_irreversible_block_round_number,json=confirmedIrreversibleBlockRoundNumber,proto3" json:"confirmed_irreversible_block_round_number,omitempty"`
+	// Is miner list different from the the miner list in the previous round.
+	IsMinerListJustChanged bool `protobuf:"varint,9,opt,name=is_miner_list_just_changed,json=isMinerListJustChanged,proto3" json:"is_miner_list_just_changed,omitempty"`
+	// The round id, calculated by summing block producers’ expecting time (second).
+	RoundIdForValidation int64 `protobuf:"varint,10,opt,name=round_id_for_validation,json=roundIdForValidation,proto3" json:"round_id_for_validation,omitempty"`
+}
+
+func (x *Round) Reset() {
+	*x = Round{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aelf_aedpos_contract_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Round) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Round) ProtoMessage() {}
+
+func (x *Round) ProtoReflect() protoreflect.Message {
+	mi := &file_aelf_aedpos_contract_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Round.ProtoReflect.Descriptor instead.
+func (*Round) Descriptor() ([]byte, []int) {
+	return file_aelf_aedpos_contract_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Round) GetRoundNumber() int64 {
+	if x != nil {
+		return x.RoundNumber
+	}
+	return 0
+}
+
+func (x *Round) GetRealTimeMinersInformation() map[string]*MinerInRound {
+	if x != nil {
+		return x.RealTimeMinersInformation
+	}
+	return nil
+}
+
+func (x *Round) GetMainChainMinersRoundNumber() int64 {
+	if x != nil {
+		return x.MainChainMinersRoundNumber
+	}
+	return 0
+}
+
+func (x *Round) GetBlockchainAge() int64 {
+	if x != nil {
+		return x.BlockchainAge
+	}
+	return 0
+}
+
+func (x *Round) GetExtraBlockProducerOfPreviousRound() string {
+	if x != nil {
+		return x.ExtraBlockProducerOfPreviousRound
+	}
+	return ""
+}
+
+func (x *Round) GetTermNumber() int64 {
+	if x != nil {
+		return x.TermNumber
+	}
+	return 0
+}
+
+func (x *Round) GetConfirmedIrreversibleBlockHeight() int64 {
+	if x != nil {
+		return x.ConfirmedIrreversibleBlockHeight
+	}
+	return 0
+}
+
+func (x *Round) GetConfirmedIrreversibleBlockRoundNumber() int64 {
+	if x != nil {
+		return x.ConfirmedIrreversibleBlockRoundNumber
+	}
+	return 0
+}
+
+func (x *Round) GetIsMinerListJustChanged() bool {
+	if x != nil {
+		return x.IsMinerListJustChanged
+	}
+	return false
+}
+
+func (x *Round) GetRoundIdForValidation() int64 {
+	if x != nil {
+		return x.RoundIdForValidation
+	}
+	return 0
+}
+
+type MinerInRound struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The order of the miner producing block.
+	Order int32 `protobuf:"varint,1,opt,name=order,proto3" json:"order,omitempty"`
+	// Is extra block producer in the current round.
+	IsExtraBlockProducer bool `protobuf:"varint,2,opt,name=is_extra_block_producer,json=isExtraBlockProducer,proto3" json:"is_extra_block_producer,omitempty"`
+	// Generated by secret sharing and used for validation between miner.
+	InValue *Hash `protobuf:"bytes,3,opt,name=in_value,json=inValue,proto3" json:"in_value,omitempty"`
+	// Calculated from current in value.
+	OutValue *Hash `protobuf:"bytes,4,opt,name=out_value,json=outValue,proto3" json:"out_value,omitempty"`
+	// Calculated from current in value and in values of the previous round.
+	Signature *Hash `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	// The expected mining time.
+	ExpectedMiningTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expected_mining_time,json=expectedMiningTime,proto3" json:"expected_mining_time,omitempty"`
+	// The amount of produced blocks.
+	ProducedBlocks int64 `protobuf:"varint,7,opt,name=produced_blocks,json=producedBlocks,proto3" json:"produced_blocks,omitempty"`
+	// The amount of missed time slots.
+	MissedTimeSlots int64 `protobuf:"varint,8,opt,name=missed_time_slots,json=missedTimeSlots,proto3" json:"missed_time_slots,omitempty"`
+	// The public key of this miner.
+	Pubkey string `protobuf:"bytes,9,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// The InValue of the previous round.
+	PreviousInValue *Hash `protobuf:"bytes,10,opt,name=previous_in_value,json=previousInValue,proto3" json:"previous_in_value,omitempty"`
+	// The supposed order of mining for the next round.
+	SupposedOrderOfNextRound int32 `protobuf:"varint,11,opt,name=supposed_order_of_next_round,json=supposedOrderOfNextRound,proto3" json:"supposed_order_of_next_round,omitempty"`
+	// The final order of mining for the next round.
+	FinalOrderOfNextRound int32 `protobuf:"varint,12,opt,name=final_order_of_next_round,json=finalOrderOfNextRound,proto3" json:"final_order_of_next_round,omitempty"`
+	// The actual mining time, miners must fill actual mining time when they do the mining.
+	ActualMiningTimes []*timestamppb.Timestamp `protobuf:"bytes,13,rep,name=actual_mining_times,json=actualMiningTimes,proto3" json:"actual_mining_times,omitempty"`
+	// The encrypted pieces of InValue.
+	EncryptedPieces map[string][]byte `protobuf:"bytes,14,rep,name=encrypted_pieces,json=encryptedPieces,proto3" json:"encrypted_pieces,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The decrypted pieces of InValue.
+	DecryptedPieces map[string][]byte `protobuf:"bytes,15,rep,name=decrypted_pieces,json=decryptedPieces,proto3" json:"decrypted_pieces,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The amount of produced tiny blocks.
+	ProducedTinyBlocks int64 `protobuf:"varint,16,opt,name=produced_tiny_blocks,json=producedTinyBlocks,proto3" json:"produced_tiny_blocks,omitempty"`
+	// The irreversible block height that current miner recorded.
+	ImpliedIrreversibleBlockHeight int64 `protobuf:"varint,17,opt,name=implied_irreversible_block_height,json=impliedIrreversibleBlockHeight,proto3" json:"implied_irreversible_block_height,omitempty"`
+}
+
+func (x *MinerInRound) Reset() {
+	*x = MinerInRound{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aelf_aedpos_contract_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MinerInRound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinerInRound) ProtoMessage() {}
+
+func (x *MinerInRound) ProtoReflect() protoreflect.Message {
+	mi := &file_aelf_aedpos_contract_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MinerInRound.ProtoReflect.Descriptor instead.
+func (*MinerInRound) Descriptor() ([]byte, []int) {
+	return file_aelf_aedpos_contract_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MinerInRound) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *MinerInRound) GetIsExtraBlockProducer() bool {
+	if x != nil {
+		return x.IsExtraBlockProducer
+	}
+	return false
+}
+
+func (x *MinerInRound) GetInValue() *Hash {
+	if x != nil {
+		return x.InValue
+	}
+	return nil
+}
+
+func (x *MinerInRound) GetOutValue() *Hash {
+	if x != nil {
+		return x.OutValue
+	}
+	return nil
+}
+
+func (x *MinerInRound) GetSignature() *Hash {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *MinerInRound) GetExpectedMiningTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedMiningTime
+	}
+	return nil
+}
+
+func (x *MinerInRound) GetProducedBlocks() int64 {
+	if x != nil {
+		return x.ProducedBlocks
+	}
+	return 0
+}
+
+func (x *MinerInRound) GetMissedTimeSlots() int64 {
+	if x != nil {
+		return x.MissedTimeSlots
+	}
+	return 0
+}
+
+func (x *MinerInRound) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *MinerInRound) GetPreviousInValue() *Hash {
+	if x != nil {
+		return x.PreviousInValue
+	}
+	return nil
+}
+
+func (x *MinerInRound) GetSupposedOrderOfNextRound() int32 {
+	if x != nil {
+		return x.SupposedOrderOfNextRound
+	}
+	return 0
+}
+
+func (x *MinerInRound) GetFinalOrderOfNextRound() int32 {
+	if x != nil {
+		return x.FinalOrderOfNextRound
+	}
+	return 0
+}
+
+func (x *MinerInRound) GetActualMiningTimes() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.ActualMiningTimes
+	}
+	return nil
+}
+
+func (x *MinerInRound) GetEncryptedPieces() map[string][]byte {
+	if x != nil {
+		return x.EncryptedPieces
+	}
+	return nil
+}
+
+func (x *MinerInRound) GetDecryptedPieces() map[string][]byte {
+	if x != nil {
+		return x.DecryptedPieces
+	}
+	return nil
+}
+
+func (x *MinerInRound) GetProducedTinyBlocks() int64 {
+	if x != nil {
+		return x.ProducedTinyBlocks
+	}
+	return 0
+}
+
+func (x *MinerInRound) GetImpliedIrreversibleBlockHeight() int64 {
+	if x != nil {
+		return x.ImpliedIrreversibleBlockHeight
+	}
+	return 0
+}
+
+type MiningInformationUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The miner public key.
+	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// The current block time.
+	MiningTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=mining_time,json=miningTime,proto3" json:"mining_time,omitempty"`
+	// The behaviour of consensus.
+	Behaviour string `protobuf:"bytes,3,opt,name=behaviour,proto3" json:"behaviour,omitempty"`
+	// The current block height.
+	BlockHeight int64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The previous block hash.
+	PreviousBlockHash *Hash `protobuf:"bytes,5,opt,name=previous_block_hash,json=previousBlockHash,proto3" json:"previous_block_hash,omitempty"`
+}
+
+func (x *MiningInformationUpdated) Reset() {
+	*x = MiningInformationUpdated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aelf_aedpos_contract_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MiningInformationUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MiningInformationUpdated) ProtoMessage() {}
+
+func (x *MiningInformationUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_aelf_aedpos_contract_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MiningInformationUpdated.ProtoReflect.Descriptor instead.
+func (*MiningInformationUpdated) Descriptor() ([]byte, []int) {
+	return file_aelf_aedpos_contract_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MiningInformationUpdated) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *MiningInformationUpdated) GetMiningTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MiningTime
+	}
+	return nil
+}
+
+func (x *MiningInformationUpdated) GetBehaviour() string {
+	if x != nil {
+		return x.Behaviour
+	}
+	return ""
+}
+
+func (x *MiningInformationUpdated) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *MiningInformationUpdated) GetPreviousBlockHash() *Hash {
+	if x != nil {
+		return x.PreviousBlockHash
+	}
+	return nil
+}
+
+type IrreversibleBlockFound struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The irreversible block height found.
+	IrreversibleBlockHeight int64 `protobuf:"varint,1,opt,name=irreversible_block_height,json=irreversibleBlockHeight,proto3" json:"irreversible_block_height,omitempty"`
+}
+
+func (x *IrreversibleBlockFound) Reset() {
+	*x = IrreversibleBlockFound{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aelf_aedpos_contract_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IrreversibleBlockFound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IrreversibleBlockFound) ProtoMessage() {}
+
+func (x *IrreversibleBlockFound) ProtoReflect() protoreflect.Message {
+	mi := &file_aelf_aedpos_contract_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IrreversibleBlockFound.ProtoReflect.Descriptor instead.
+func (*IrreversibleBlockFound) Descriptor() ([]byte, []int) {
+	return file_aelf_aedpos_contract_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *IrreversibleBlockFound) GetIrreversibleBlockHeight() int64 {
+	if x != nil {
+		return x.IrreversibleBlockHeight
+	}
+	return 0
+}
+
+var File_aelf_aedpos_contract_proto protoreflect.FileDescriptor
+
+var file_aelf_aedpos_contract_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x61, 0x65, 0x6c, 0x66, 0x2f, 0x61, 0x65, 0x64, 0x70, 0x6f, 0x73, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x61, 0x65,
+	0x6c, 0x66, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x61, 0x65, 0x6c, 0x66, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa4, 0x01, 0x0a, 0x1e, 0x41, 0x45, 0x6c, 0x66, 0x43, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x05,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x65,
+	0x6c, 0x66, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x3a, 0x0a, 0x09, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x75, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x41, 0x45, 0x6c, 0x66, 0x43, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x75, 0x72,
+	0x52, 0x09, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x75, 0x72, 0x22, 0xf4, 0x05, 0x0a, 0x05,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x6b, 0x0a, 0x1c, 0x72, 0x65, 0x61, 0x6c,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x6c,
+	0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x19, 0x72, 0x65, 0x61, 0x6c,
+	0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x1e, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x6d,
+	0x61, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x67, 0x65,
+	0x12, 0x51, 0x0a, 0x26, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x21, 0x65, 0x78, 0x74, 0x72, 0x61, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x65, 0x72, 0x4f, 0x66, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x4d, 0x0a, 0x23, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65,
+	0x64, 0x5f, 0x69, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x49, 0x72, 0x72, 0x65,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x58, 0x0a, 0x29, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64,
+	0x5f, 0x69, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x25, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65,
+	0x64, 0x49, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3a, 0x0a,
+	0x1a, 0x69, 0x73, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6a,
+	0x75, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x16, 0x69, 0x73, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x75,
+	0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x5f, 0x69, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x49, 0x64, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0x60, 0x0a, 0x1e, 0x52, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x6e, 0x65,
+	0x72, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72,
+	0x49, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xbb, 0x08, 0x0a, 0x0c, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x17, 0x69, 0x73, 0x5f,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x73, 0x45, 0x78,
+	0x74, 0x72, 0x61, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72,
+	0x12, 0x25, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x07,
+	0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x27, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x65, 0x6c,
+	0x66, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x28, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x4c, 0x0a, 0x14, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4d, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x69,
+	0x73, 0x73, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x0f, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x49, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3e, 0x0a,
+	0x1c, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x6f, 0x66, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x18, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x4f, 0x66, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x38, 0x0a,
+	0x19, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x15, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4f, 0x66, 0x4e, 0x65,
+	0x78, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x4a, 0x0a, 0x13, 0x61, 0x63, 0x74, 0x75, 0x61,
+	0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x0d,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x11, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x10, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64,
+	0x5f, 0x70, 0x69, 0x65, 0x63, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x61, 0x65, 0x6c, 0x66, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x50, 0x69, 0x65, 0x63, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65,
+	0x64, 0x50, 0x69, 0x65, 0x63, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x10, 0x64, 0x65, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x69, 0x65, 0x63, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x50,
+	0x69, 0x65, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x64, 0x65, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x65, 0x64, 0x50, 0x69, 0x65, 0x63, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6e, 0x79, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x65, 0x64, 0x54, 0x69, 0x6e, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x49, 0x0a,
+	0x21, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x69, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1e, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x65,
+	0x64, 0x49, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x1a, 0x42, 0x0a, 0x14, 0x45, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x65, 0x64, 0x50, 0x69, 0x65, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14,
+	0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x50, 0x69, 0x65, 0x63, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xec, 0x01, 0x0a, 0x18, 0x4d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x75, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x75, 0x72,
+	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x3a, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x11, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x22,
+	0x54, 0x0a, 0x16, 0x49, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x69, 0x72, 0x72,
+	0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x69, 0x72,
+	0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x2a, 0x66, 0x0a, 0x16, 0x41, 0x45, 0x6c, 0x66, 0x43, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x75, 0x72, 0x12,
+	0x10, 0x0a, 0x0c, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10,
+	0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x45, 0x58, 0x54, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x45, 0x58, 0x54, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x10, 0x02,
+	0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x4f, 0x54, 0x48, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x0e, 0x0a,
+	0x0a, 0x54, 0x49, 0x4e, 0x59, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x04, 0x42, 0x48, 0x5a,
+	0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x66, 0x61, 0x73, 0x74, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x68, 0x6f,
+	0x73, 0x65, 0x2d, 0x61, 0x65, 0x6c, 0x66, 0x2f, 0x70, 0x62, 0x2f, 0x61, 0x65, 0x6c, 0x66, 0x3b,
+	0x61, 0x65, 0x6c, 0x66, 0xaa, 0x02, 0x10, 0x41, 0x45, 0x6c, 0x66, 0x2e, 0x46, 0x69, 0x72, 0x65,
+	0x68, 0x6f, 0x73, 0x65, 0x2e, 0x50, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_aelf_aedpos_contract_proto_rawDescOnce sync.Once
+	file_aelf_aedpos_contract_proto_rawDescData = file_aelf_aedpos_contract_proto_rawDesc
+)
+
+func file_aelf_aedpos_contract_proto_rawDescGZIP() []byte {
+	file_aelf_aedpos_contract_proto_rawDescOnce.Do(func() {
+		file_aelf_aedpos_contract_proto_rawDescData = protoimpl.X.CompressGZIP(file_aelf_aedpos_contract_proto_rawDescData)
+	})
+	return file_aelf_aedpos_contract_proto_rawDescData
+}
+
+var file_aelf_aedpos_contract_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_aelf_aedpos_contract_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_aelf_aedpos_contract_proto_goTypes = []any{
+	(AElfConsensusBehaviour)(0),            // 0: aelf.AElfConsensusBehaviour
+	(*AElfConsensusHeaderInformation)(nil), // 1: aelf.AElfConsensusHeaderInformation
+	(*Round)(nil),                          // 2: aelf.Round
+	(*MinerInRound)(nil),                   // 3: aelf.MinerInRound
+	(*MiningInformationUpdated)(nil),       // 4: aelf.MiningInformationUpdated
+	(*IrreversibleBlockFound)(nil),         // 5: aelf.IrreversibleBlockFound
+	nil,                                    // 6: aelf.Round.RealTimeMinersInformationEntry
+	nil,                                    // 7: aelf.MinerInRound.EncryptedPiecesEntry
+	nil,                                    // 8: aelf.MinerInRound.DecryptedPiecesEntry
+	(*Hash)(nil),                           // 9: aelf.Hash
+	(*timestamppb.Timestamp)(nil),          // 10: google.protobuf.Timestamp
+}
+var file_aelf_aedpos_contract_proto_depIdxs = []int32{
+	2,  // 0: aelf.AElfConsensusHeaderInformation.round:type_name -> aelf.Round
+	0,  // 1: aelf.AElfConsensusHeaderInformation.behaviour:type_name -> aelf.AElfConsensusBehaviour
+	6,  // 2: aelf.Round.real_time_miners_information:type_name -> aelf.Round.RealTimeMinersInformationEntry
+	9,  // 3: aelf.MinerInRound.in_value:type_name -> aelf.Hash
+	9,  // 4: aelf.MinerInRound.out_value:type_name -> aelf.Hash
+	9,  // 5: aelf.MinerInRound.signature:type_name -> aelf.Hash
+	10, // 6: aelf.MinerInRound.expected_mining_time:type_name -> google.protobuf.Timestamp
+	9,  // 7: aelf.MinerInRound.previous_in_value:type_name -> aelf.Hash
+	10, // 8: aelf.MinerInRound.actual_mining_times:type_name -> google.protobuf.Timestamp
+	7,  // 9: aelf.MinerInRound.encrypted_pieces:type_name -> aelf.MinerInRound.EncryptedPiecesEntry
+	8,  // 10: aelf.MinerInRound.decrypted_pieces:type_name -> aelf.MinerInRound.DecryptedPiecesEntry
+	10, // 11: aelf.MiningInformationUpdated.mining_time:type_name -> google.protobuf.Timestamp
+	9,  // 12: aelf.MiningInformationUpdated.previous_block_hash:type_name -> aelf.Hash
+	3,  // 13: aelf.Round.RealTimeMinersInformationEntry.value:type_name -> aelf.MinerInRound
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_aelf_aedpos_contract_proto_init() }
+func file_aelf_aedpos_contract_proto_init() {
+	if File_aelf_aedpos_contract_proto != nil {
+		return
+	}
+	file_aelf_core_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_aelf_aedpos_contract_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*AElfConsensusHeaderInformation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aelf_aedpos_contract_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Round); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aelf_aedpos_contract_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*MinerInRound); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aelf_aedpos_contract_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*MiningInformationUpdated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aelf_aedpos_contract_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*IrreversibleBlockFound); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_aelf_aedpos_contract_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_aelf_aedpos_contract_proto_goTypes,
+		DependencyIndexes: file_aelf_aedpos_contract_proto_depIdxs,
+		EnumInfos:         file_aelf_aedpos_contract_proto_enumTypes,
+		MessageInfos:      file_aelf_aedpos_contract_proto_msgTypes,
+	}.Build()
+	File_aelf_aedpos_contract_proto = out.File
+	file_aelf_aedpos_contract_proto_rawDesc = nil
+	file_aelf_aedpos_contract_proto_goTypes = nil
+	file_aelf_aedpos_contract_proto_depIdxs = nil
+}
@@ -0,0 +1,29 @@
+package aelf
+
+import (
+	"google.golang.org/protobuf/proto"
+)
+
+// knownEvents maps the event names to the vendored messages able to decode them. Event names are only
+// unique within a contract, decoding an event emitted by an unrelated contract under the same name is
+// best effort.
+var knownEvents = map[string]func() proto.Message{
+	"Transferred":              func() proto.Message { return new(Transferred) },
+	"Issued":                   func() proto.Message { return new(Issued) },
+	"Burned":                   func() proto.Message { return new(Burned) },
+	"TransactionFeeCharged":    func() proto.Message { return new(TransactionFeeCharged) },
+	"CrossChainTransferred":    func() proto.Message { return new(CrossChainTransferred) },
+	"CrossChainReceived":       func() proto.Message { return new(CrossChainReceived) },
+	"MiningInformationUpdated": func() proto.Message { return new(MiningInformationUpdated) },
+	"IrreversibleBlockFound":   func() proto.Message { return new(IrreversibleBlockFound) },
+	"ContractDeployed":         func() proto.Message { return new(ContractDeployed) },
+}
+
+// NewKnownEvent returns an empty message for the event named name, false when the event is unknown.
+func NewKnownEvent(name string) (proto.Message, bool) {
+	factory, found := knownEvents[name]
+	if !found {
+		return nil, false
+	}
+	return factory(), true
+}
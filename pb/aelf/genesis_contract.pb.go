@@ -0,0 +1,226 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.20.3
+// source: aelf/genesis_contract.proto
+
+package aelf
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ContractDeployed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The author of the contract, this is the person who deployed the contract.
+	Author *Address `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	// The hash of the contract code.
+	CodeHash *Hash `protobuf:"bytes,2,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	// The address of the contract.
+	Address *Address `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// The version of the current contract.
+	Version int32 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	// The name of the contract. It has to be unique.
+	Name *Hash `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	// The version of the current contract.
+	ContractVersion string `protobuf:"bytes,6,opt,name=contract_version,json=contractVersion,proto3" json:"contract_version,omitempty"`
+	// The deployer of the contract.
+	Deployer *Address `protobuf:"bytes,7,opt,name=deployer,proto3" json:"deployer,omitempty"`
+}
+
+func (x *ContractDeployed) Reset() {
+	*x = ContractDeployed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aelf_genesis_contract_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContractDeployed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractDeployed) ProtoMessage() {}
+
+func (x *ContractDeployed) ProtoReflect() protoreflect.Message {
+	mi := &file_aelf_genesis_contract_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractDeployed.ProtoReflect.Descriptor instead.
+func (*ContractDeployed) Descriptor() ([]byte, []int) {
+	return file_aelf_genesis_contract_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ContractDeployed) GetAuthor() *Address {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *ContractDeployed) GetCodeHash() *Hash {
+	if x != nil {
+		return x.CodeHash
+	}
+	return nil
+}
+
+func (x *ContractDeployed) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *ContractDeployed) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ContractDeployed) GetName() *Hash {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *ContractDeployed) GetContractVersion() string {
+	if x != nil {
+		return x.ContractVersion
+	}
+	return ""
+}
+
+func (x *ContractDeployed) GetDeployer() *Address {
+	if x != nil {
+		return x.Deployer
+	}
+	return nil
+}
+
+var File_aelf_genesis_contract_proto protoreflect.FileDescriptor
+
+var file_aelf_genesis_contract_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x61, 0x65, 0x6c, 0x66, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x61,
+	0x65, 0x6c, 0x66, 0x1a, 0x0f, 0x61, 0x65, 0x6c, 0x66, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9b, 0x02, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x06, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x65, 0x6c, 0x66,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x12, 0x27, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52,
+	0x08, 0x63, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x27, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x65, 0x6c,
+	0x66, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x65, 0x6c,
+	0x66, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x08, 0x64, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x65, 0x6c, 0x66,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x65, 0x72, 0x42, 0x48, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x66, 0x61, 0x73, 0x74, 0x2f, 0x66,
+	0x69, 0x72, 0x65, 0x68, 0x6f, 0x73, 0x65, 0x2d, 0x61, 0x65, 0x6c, 0x66, 0x2f, 0x70, 0x62, 0x2f,
+	0x61, 0x65, 0x6c, 0x66, 0x3b, 0x61, 0x65, 0x6c, 0x66, 0xaa, 0x02, 0x10, 0x41, 0x45, 0x6c, 0x66,
+	0x2e, 0x46, 0x69, 0x72, 0x65, 0x68, 0x6f, 0x73, 0x65, 0x2e, 0x50, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_aelf_genesis_contract_proto_rawDescOnce sync.Once
+	file_aelf_genesis_contract_proto_rawDescData = file_aelf_genesis_contract_proto_rawDesc
+)
+
+func file_aelf_genesis_contract_proto_rawDescGZIP() []byte {
+	file_aelf_genesis_contract_proto_rawDescOnce.Do(func() {
+		file_aelf_genesis_contract_proto_rawDescData = protoimpl.X.CompressGZIP(file_aelf_genesis_contract_proto_rawDescData)
+	})
+	return file_aelf_genesis_contract_proto_rawDescData
+}
+
+var file_aelf_genesis_contract_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_aelf_genesis_contract_proto_goTypes = []any{
+	(*ContractDeployed)(nil), // 0: aelf.ContractDeployed
+	(*Address)(nil),          // 1: aelf.Address
+	(*Hash)(nil),             // 2: aelf.Hash
+}
+var file_aelf_genesis_contract_proto_depIdxs = []int32{
+	1, // 0: aelf.ContractDeployed.author:type_name -> aelf.Address
+	2, // 1: aelf.ContractDeployed.code_hash:type_name -> aelf.Hash
+	1, // 2: aelf.ContractDeployed.address:type_name -> aelf.Address
+	2, // 3: aelf.ContractDeployed.name:type_name -> aelf.Hash
+	1, // 4: aelf.ContractDeployed.deployer:type_name -> aelf.Address
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_aelf_genesis_contract_proto_init() }
+func file_aelf_genesis_contract_proto_init() {
+	if File_aelf_genesis_contract_proto != nil {
+		return
+	}
+	file_aelf_core_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_aelf_genesis_contract_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ContractDeployed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_aelf_genesis_contract_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_aelf_genesis_contract_proto_goTypes,
+		DependencyIndexes: file_aelf_genesis_contract_proto_depIdxs,
+		MessageInfos:      file_aelf_genesis_contract_proto_msgTypes,
+	}.Build()
+	File_aelf_genesis_contract_proto = out.File
+	file_aelf_genesis_contract_proto_rawDesc = nil
+	file_aelf_genesis_contract_proto_goTypes = nil
+	file_aelf_genesis_contract_proto_depIdxs = nil
+}
@@ -0,0 +1,29 @@
+package aelf
+
+import (
+	"google.golang.org/protobuf/proto"
+)
+
+// knownMethodInputs maps the method names to the vendored messages able to decode their input. Like event
+// names, method names are only unique within a contract, decoding the input of an unrelated contract
+// method under the same name is best effort.
+var knownMethodInputs = map[string]func() proto.Message{
+	"Transfer":     func() proto.Message { return new(TransferInput) },
+	"TransferFrom": func() proto.Message { return new(TransferFromInput) },
+	"Approve":      func() proto.Message { return new(ApproveInput) },
+	"Issue":        func() proto.Message { return new(IssueInput) },
+	"Burn":         func() proto.Message { return new(BurnInput) },
+	// NextRoundInput and NextTermInput share the Round field numbers
+	"NextRound": func() proto.Message { return new(Round) },
+	"NextTerm":  func() proto.Message { return new(Round) },
+}
+
+// NewKnownMethodInput returns an empty message for the input of the method named name, false when the
+// method is unknown.
+func NewKnownMethodInput(name string) (proto.Message, bool) {
+	factory, found := knownMethodInputs[name]
+	if !found {
+		return nil, false
+	}
+	return factory(), true
+}
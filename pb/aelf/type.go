@@ -1,8 +1,10 @@
 package aelf
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"github.com/btcsuite/btcutil/base58"
 )
 
@@ -13,13 +15,33 @@ var (
 )
 
 func (h *Hash) ToHex() string {
-	return hex.EncodeToString(h.Value)
+	return hex.EncodeToString(h.GetValue())
+}
+
+// AddressFromPublicKey returns the address of an account from its public key, like `Address.FromPublicKey`
+// in AElf.
+func AddressFromPublicKey(pubkey []byte) *Address {
+	firstHash := sha256.Sum256(pubkey)
+	secondHash := sha256.Sum256(firstHash[:])
+	return &Address{Value: secondHash[:]}
 }
 
 func (a *Address) ToBase58() string {
+	if a == nil {
+		return ""
+	}
 	return base58EncodeWithChecksum(a.Value)
 }
 
+// AddressFromBase58 decodes a base58 address as rendered by `ToBase58`, verifying its checksum.
+func AddressFromBase58(encoded string) (*Address, error) {
+	data, err := base58DecodeWithChecksum(encoded)
+	if err != nil {
+		return nil, err
+	}
+	return &Address{Value: data}, nil
+}
+
 // Function to calculate double SHA-256 hash and return the first 4 bytes as checksum
 func checksum(data []byte) []byte {
 	firstHash := sha256.Sum256(data)
@@ -39,3 +61,17 @@ func base58EncodeWithChecksum(data []byte) string {
 	encoded := base58.Encode(dataWithChecksum)
 	return encoded
 }
+
+// Function to decode data encoded using Base58 with checksum, verifying the checksum
+func base58DecodeWithChecksum(encoded string) ([]byte, error) {
+	decoded := base58.Decode(encoded)
+	if len(decoded) <= 4 {
+		return nil, errors.New("invalid base58 data")
+	}
+
+	data, expected := decoded[:len(decoded)-4], decoded[len(decoded)-4:]
+	if !bytes.Equal(checksum(data), expected) {
+		return nil, errors.New("invalid base58 checksum")
+	}
+	return data, nil
+}
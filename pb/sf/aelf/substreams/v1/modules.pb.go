@@ -0,0 +1,895 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.20.3
+// source: sf/aelf/substreams/v1/modules.proto
+
+package pbsubstreams
+
+import (
+	v1 "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TokenTransfer_Type int32
+
+const (
+	// `Transferred` event.
+	TokenTransfer_TRANSFER TokenTransfer_Type = 0
+	// `Issued` event.
+	TokenTransfer_ISSUE TokenTransfer_Type = 1
+	// `Burned` event.
+	TokenTransfer_BURN TokenTransfer_Type = 2
+	// `TransactionFeeCharged` event.
+	TokenTransfer_FEE TokenTransfer_Type = 3
+	// `CrossChainTransferred` event, the tokens leave the chain.
+	TokenTransfer_CROSS_CHAIN_TRANSFER TokenTransfer_Type = 4
+	// `CrossChainReceived` event, the tokens enter the chain.
+	TokenTransfer_CROSS_CHAIN_RECEIVE TokenTransfer_Type = 5
+)
+
+// Enum value maps for TokenTransfer_Type.
+var (
+	TokenTransfer_Type_name = map[int32]string{
+		0: "TRANSFER",
+		1: "ISSUE",
+		2: "BURN",
+		3: "FEE",
+		4: "CROSS_CHAIN_TRANSFER",
+		5: "CROSS_CHAIN_RECEIVE",
+	}
+	TokenTransfer_Type_value = map[string]int32{
+		"TRANSFER":             0,
+		"ISSUE":                1,
+		"BURN":                 2,
+		"FEE":                  3,
+		"CROSS_CHAIN_TRANSFER": 4,
+		"CROSS_CHAIN_RECEIVE":  5,
+	}
+)
+
+func (x TokenTransfer_Type) Enum() *TokenTransfer_Type {
+	p := new(TokenTransfer_Type)
+	*p = x
+	return p
+}
+
+func (x TokenTransfer_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TokenTransfer_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_sf_aelf_substreams_v1_modules_proto_enumTypes[0].Descriptor()
+}
+
+func (TokenTransfer_Type) Type() protoreflect.EnumType {
+	return &file_sf_aelf_substreams_v1_modules_proto_enumTypes[0]
+}
+
+func (x TokenTransfer_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TokenTransfer_Type.Descriptor instead.
+func (TokenTransfer_Type) EnumDescriptor() ([]byte, []int) {
+	return file_sf_aelf_substreams_v1_modules_proto_rawDescGZIP(), []int{5, 0}
+}
+
+// Output of `map_filtered_calls`.
+type FilteredCalls struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Calls []*FilteredCall `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls,omitempty"`
+}
+
+func (x *FilteredCalls) Reset() {
+	*x = FilteredCalls{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_aelf_substreams_v1_modules_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilteredCalls) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilteredCalls) ProtoMessage() {}
+
+func (x *FilteredCalls) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_aelf_substreams_v1_modules_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilteredCalls.ProtoReflect.Descriptor instead.
+func (*FilteredCalls) Descriptor() ([]byte, []int) {
+	return file_sf_aelf_substreams_v1_modules_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FilteredCalls) GetCalls() []*FilteredCall {
+	if x != nil {
+		return x.Calls
+	}
+	return nil
+}
+
+type FilteredCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionId   string             `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	TransactionKind v1.TransactionKind `protobuf:"varint,2,opt,name=transaction_kind,json=transactionKind,proto3,enum=sf.aelf.type.v1.TransactionKind" json:"transaction_kind,omitempty"`
+	Call            *v1.Call           `protobuf:"bytes,3,opt,name=call,proto3" json:"call,omitempty"`
+}
+
+func (x *FilteredCall) Reset() {
+	*x = FilteredCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_aelf_substreams_v1_modules_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilteredCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilteredCall) ProtoMessage() {}
+
+func (x *FilteredCall) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_aelf_substreams_v1_modules_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilteredCall.ProtoReflect.Descriptor instead.
+func (*FilteredCall) Descriptor() ([]byte, []int) {
+	return file_sf_aelf_substreams_v1_modules_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FilteredCall) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *FilteredCall) GetTransactionKind() v1.TransactionKind {
+	if x != nil {
+		return x.TransactionKind
+	}
+	return v1.TransactionKind(0)
+}
+
+func (x *FilteredCall) GetCall() *v1.Call {
+	if x != nil {
+		return x.Call
+	}
+	return nil
+}
+
+// Output of `map_events`.
+type Events struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *Events) Reset() {
+	*x = Events{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_aelf_substreams_v1_modules_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Events) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Events) ProtoMessage() {}
+
+func (x *Events) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_aelf_substreams_v1_modules_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Events.ProtoReflect.Descriptor instead.
+func (*Events) Descriptor() ([]byte, []int) {
+	return file_sf_aelf_substreams_v1_modules_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Events) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionId string       `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	CallPath      string       `protobuf:"bytes,2,opt,name=call_path,json=callPath,proto3" json:"call_path,omitempty"`
+	Log           *v1.LogEvent `protobuf:"bytes,3,opt,name=log,proto3" json:"log,omitempty"`
+	// The position of the event in the block, across all the transactions.
+	Ordinal uint64 `protobuf:"varint,4,opt,name=ordinal,proto3" json:"ordinal,omitempty"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_aelf_substreams_v1_modules_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_aelf_substreams_v1_modules_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_sf_aelf_substreams_v1_modules_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Event) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *Event) GetCallPath() string {
+	if x != nil {
+		return x.CallPath
+	}
+	return ""
+}
+
+func (x *Event) GetLog() *v1.LogEvent {
+	if x != nil {
+		return x.Log
+	}
+	return nil
+}
+
+func (x *Event) GetOrdinal() uint64 {
+	if x != nil {
+		return x.Ordinal
+	}
+	return 0
+}
+
+// Output of `map_token_transfers`.
+type TokenTransfers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transfers []*TokenTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+}
+
+func (x *TokenTransfers) Reset() {
+	*x = TokenTransfers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_aelf_substreams_v1_modules_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenTransfers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenTransfers) ProtoMessage() {}
+
+func (x *TokenTransfers) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_aelf_substreams_v1_modules_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenTransfers.ProtoReflect.Descriptor instead.
+func (*TokenTransfers) Descriptor() ([]byte, []int) {
+	return file_sf_aelf_substreams_v1_modules_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TokenTransfers) GetTransfers() []*TokenTransfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+type TokenTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionId string             `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	CallPath      string             `protobuf:"bytes,2,opt,name=call_path,json=callPath,proto3" json:"call_path,omitempty"`
+	Type          TokenTransfer_Type `protobuf:"varint,3,opt,name=type,proto3,enum=sf.aelf.substreams.v1.TokenTransfer_Type" json:"type,omitempty"`
+	// Unset for issues and cross chain receives.
+	From string `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	// Unset for burns, fees and cross chain transfers.
+	To     string `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Symbol string `protobuf:"bytes,6,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Amount int64  `protobuf:"varint,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Memo   string `protobuf:"bytes,8,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The ordinal of the event of the transfer in the block.
+	Ordinal uint64 `protobuf:"varint,9,opt,name=ordinal,proto3" json:"ordinal,omitempty"`
+}
+
+func (x *TokenTransfer) Reset() {
+	*x = TokenTransfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_aelf_substreams_v1_modules_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenTransfer) ProtoMessage() {}
+
+func (x *TokenTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_aelf_substreams_v1_modules_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenTransfer.ProtoReflect.Descriptor instead.
+func (*TokenTransfer) Descriptor() ([]byte, []int) {
+	return file_sf_aelf_substreams_v1_modules_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TokenTransfer) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *TokenTransfer) GetCallPath() string {
+	if x != nil {
+		return x.CallPath
+	}
+	return ""
+}
+
+func (x *TokenTransfer) GetType() TokenTransfer_Type {
+	if x != nil {
+		return x.Type
+	}
+	return TokenTransfer_TRANSFER
+}
+
+func (x *TokenTransfer) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *TokenTransfer) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *TokenTransfer) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *TokenTransfer) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TokenTransfer) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *TokenTransfer) GetOrdinal() uint64 {
+	if x != nil {
+		return x.Ordinal
+	}
+	return 0
+}
+
+// Output of `map_contract_deployments`.
+type ContractDeployments struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deployments []*ContractDeployment `protobuf:"bytes,1,rep,name=deployments,proto3" json:"deployments,omitempty"`
+}
+
+func (x *ContractDeployments) Reset() {
+	*x = ContractDeployments{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_aelf_substreams_v1_modules_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContractDeployments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractDeployments) ProtoMessage() {}
+
+func (x *ContractDeployments) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_aelf_substreams_v1_modules_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractDeployments.ProtoReflect.Descriptor instead.
+func (*ContractDeployments) Descriptor() ([]byte, []int) {
+	return file_sf_aelf_substreams_v1_modules_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ContractDeployments) GetDeployments() []*ContractDeployment {
+	if x != nil {
+		return x.Deployments
+	}
+	return nil
+}
+
+type ContractDeployment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionId   string `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Address         string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Author          string `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Deployer        string `protobuf:"bytes,4,opt,name=deployer,proto3" json:"deployer,omitempty"`
+	CodeHash        string `protobuf:"bytes,5,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	Name            string `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Version         int32  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	ContractVersion string `protobuf:"bytes,8,opt,name=contract_version,json=contractVersion,proto3" json:"contract_version,omitempty"`
+}
+
+func (x *ContractDeployment) Reset() {
+	*x = ContractDeployment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_aelf_substreams_v1_modules_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContractDeployment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractDeployment) ProtoMessage() {}
+
+func (x *ContractDeployment) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_aelf_substreams_v1_modules_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractDeployment.ProtoReflect.Descriptor instead.
+func (*ContractDeployment) Descriptor() ([]byte, []int) {
+	return file_sf_aelf_substreams_v1_modules_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ContractDeployment) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *ContractDeployment) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ContractDeployment) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *ContractDeployment) GetDeployer() string {
+	if x != nil {
+		return x.Deployer
+	}
+	return ""
+}
+
+func (x *ContractDeployment) GetCodeHash() string {
+	if x != nil {
+		return x.CodeHash
+	}
+	return ""
+}
+
+func (x *ContractDeployment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContractDeployment) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ContractDeployment) GetContractVersion() string {
+	if x != nil {
+		return x.ContractVersion
+	}
+	return ""
+}
+
+var File_sf_aelf_substreams_v1_modules_proto protoreflect.FileDescriptor
+
+var file_sf_aelf_substreams_v1_modules_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x73, 0x66, 0x2f, 0x61, 0x65, 0x6c, 0x66, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x73,
+	0x75, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x1a, 0x73, 0x66,
+	0x2f, 0x61, 0x65, 0x6c, 0x66, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4a, 0x0a, 0x0d, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x39, 0x0a, 0x05, 0x63, 0x61, 0x6c,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65,
+	0x6c, 0x66, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x05, 0x63,
+	0x61, 0x6c, 0x6c, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x10,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x04, 0x63, 0x61, 0x6c,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c,
+	0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x04,
+	0x63, 0x61, 0x6c, 0x6c, 0x22, 0x3e, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x34,
+	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x2b, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x12,
+	0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x22, 0x54, 0x0a, 0x0e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x22,
+	0xfb, 0x02, 0x0a, 0x0d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6c,
+	0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x73, 0x75,
+	0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x18, 0x0a, 0x07,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x22, 0x65, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c,
+	0x0a, 0x08, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
+	0x49, 0x53, 0x53, 0x55, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x55, 0x52, 0x4e, 0x10,
+	0x02, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x45, 0x45, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x52,
+	0x4f, 0x53, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46,
+	0x45, 0x52, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x52, 0x4f, 0x53, 0x53, 0x5f, 0x43, 0x48,
+	0x41, 0x49, 0x4e, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x10, 0x05, 0x22, 0x62, 0x0a,
+	0x13, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x66, 0x2e, 0x61,
+	0x65, 0x6c, 0x66, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0xff, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x72, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x42, 0x4e, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x66, 0x61, 0x73, 0x74, 0x2f,
+	0x66, 0x69, 0x72, 0x65, 0x68, 0x6f, 0x73, 0x65, 0x2d, 0x61, 0x65, 0x6c, 0x66, 0x2f, 0x70, 0x62,
+	0x2f, 0x73, 0x66, 0x2f, 0x61, 0x65, 0x6c, 0x66, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x73, 0x75, 0x62, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_sf_aelf_substreams_v1_modules_proto_rawDescOnce sync.Once
+	file_sf_aelf_substreams_v1_modules_proto_rawDescData = file_sf_aelf_substreams_v1_modules_proto_rawDesc
+)
+
+func file_sf_aelf_substreams_v1_modules_proto_rawDescGZIP() []byte {
+	file_sf_aelf_substreams_v1_modules_proto_rawDescOnce.Do(func() {
+		file_sf_aelf_substreams_v1_modules_proto_rawDescData = protoimpl.X.CompressGZIP(file_sf_aelf_substreams_v1_modules_proto_rawDescData)
+	})
+	return file_sf_aelf_substreams_v1_modules_proto_rawDescData
+}
+
+var file_sf_aelf_substreams_v1_modules_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_sf_aelf_substreams_v1_modules_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_sf_aelf_substreams_v1_modules_proto_goTypes = []any{
+	(TokenTransfer_Type)(0),     // 0: sf.aelf.substreams.v1.TokenTransfer.Type
+	(*FilteredCalls)(nil),       // 1: sf.aelf.substreams.v1.FilteredCalls
+	(*FilteredCall)(nil),        // 2: sf.aelf.substreams.v1.FilteredCall
+	(*Events)(nil),              // 3: sf.aelf.substreams.v1.Events
+	(*Event)(nil),               // 4: sf.aelf.substreams.v1.Event
+	(*TokenTransfers)(nil),      // 5: sf.aelf.substreams.v1.TokenTransfers
+	(*TokenTransfer)(nil),       // 6: sf.aelf.substreams.v1.TokenTransfer
+	(*ContractDeployments)(nil), // 7: sf.aelf.substreams.v1.ContractDeployments
+	(*ContractDeployment)(nil),  // 8: sf.aelf.substreams.v1.ContractDeployment
+	(v1.TransactionKind)(0),     // 9: sf.aelf.type.v1.TransactionKind
+	(*v1.Call)(nil),             // 10: sf.aelf.type.v1.Call
+	(*v1.LogEvent)(nil),         // 11: sf.aelf.type.v1.LogEvent
+}
+var file_sf_aelf_substreams_v1_modules_proto_depIdxs = []int32{
+	2,  // 0: sf.aelf.substreams.v1.FilteredCalls.calls:type_name -> sf.aelf.substreams.v1.FilteredCall
+	9,  // 1: sf.aelf.substreams.v1.FilteredCall.transaction_kind:type_name -> sf.aelf.type.v1.TransactionKind
+	10, // 2: sf.aelf.substreams.v1.FilteredCall.call:type_name -> sf.aelf.type.v1.Call
+	4,  // 3: sf.aelf.substreams.v1.Events.events:type_name -> sf.aelf.substreams.v1.Event
+	11, // 4: sf.aelf.substreams.v1.Event.log:type_name -> sf.aelf.type.v1.LogEvent
+	6,  // 5: sf.aelf.substreams.v1.TokenTransfers.transfers:type_name -> sf.aelf.substreams.v1.TokenTransfer
+	0,  // 6: sf.aelf.substreams.v1.TokenTransfer.type:type_name -> sf.aelf.substreams.v1.TokenTransfer.Type
+	8,  // 7: sf.aelf.substreams.v1.ContractDeployments.deployments:type_name -> sf.aelf.substreams.v1.ContractDeployment
+	8,  // [8:8] is the sub-list for method output_type
+	8,  // [8:8] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_sf_aelf_substreams_v1_modules_proto_init() }
+func file_sf_aelf_substreams_v1_modules_proto_init() {
+	if File_sf_aelf_substreams_v1_modules_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_sf_aelf_substreams_v1_modules_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*FilteredCalls); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_aelf_substreams_v1_modules_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*FilteredCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_aelf_substreams_v1_modules_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*Events); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_aelf_substreams_v1_modules_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_aelf_substreams_v1_modules_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*TokenTransfers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_aelf_substreams_v1_modules_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*TokenTransfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_aelf_substreams_v1_modules_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*ContractDeployments); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_aelf_substreams_v1_modules_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*ContractDeployment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_sf_aelf_substreams_v1_modules_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_sf_aelf_substreams_v1_modules_proto_goTypes,
+		DependencyIndexes: file_sf_aelf_substreams_v1_modules_proto_depIdxs,
+		EnumInfos:         file_sf_aelf_substreams_v1_modules_proto_enumTypes,
+		MessageInfos:      file_sf_aelf_substreams_v1_modules_proto_msgTypes,
+	}.Build()
+	File_sf_aelf_substreams_v1_modules_proto = out.File
+	file_sf_aelf_substreams_v1_modules_proto_rawDesc = nil
+	file_sf_aelf_substreams_v1_modules_proto_goTypes = nil
+	file_sf_aelf_substreams_v1_modules_proto_depIdxs = nil
+}
@@ -0,0 +1,316 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.20.3
+// source: sf/aelf/transform/v1/transforms.proto
+
+package pbtransform
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CallFilter_Status int32
+
+const (
+	// Matches any call.
+	CallFilter_ANY CallFilter_Status = 0
+	// Matches executed calls that were not reverted.
+	CallFilter_SUCCEEDED CallFilter_Status = 1
+	// Matches failed or reverted calls.
+	CallFilter_FAILED CallFilter_Status = 2
+)
+
+// Enum value maps for CallFilter_Status.
+var (
+	CallFilter_Status_name = map[int32]string{
+		0: "ANY",
+		1: "SUCCEEDED",
+		2: "FAILED",
+	}
+	CallFilter_Status_value = map[string]int32{
+		"ANY":       0,
+		"SUCCEEDED": 1,
+		"FAILED":    2,
+	}
+)
+
+func (x CallFilter_Status) Enum() *CallFilter_Status {
+	p := new(CallFilter_Status)
+	*p = x
+	return p
+}
+
+func (x CallFilter_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CallFilter_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_sf_aelf_transform_v1_transforms_proto_enumTypes[0].Descriptor()
+}
+
+func (CallFilter_Status) Type() protoreflect.EnumType {
+	return &file_sf_aelf_transform_v1_transforms_proto_enumTypes[0]
+}
+
+func (x CallFilter_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CallFilter_Status.Descriptor instead.
+func (CallFilter_Status) EnumDescriptor() ([]byte, []int) {
+	return file_sf_aelf_transform_v1_transforms_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// CallFilter keeps the transaction traces of a block having at least one call matching all the set
+// criteria, a criterion left empty matches any call. Transaction traces are kept whole, the other ones
+// are stripped from the block.
+//
+// When block indexes are available, the address, method and event criteria are used to skip the
+// blocks without any possible match.
+type CallFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Base58 addresses of the called contracts (`Call.to`).
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// Names of the called methods (`Call.method_name`).
+	Methods []string `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+	// Names of the log events emitted by the call (`LogEvent.name`).
+	Events []string          `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	Status CallFilter_Status `protobuf:"varint,4,opt,name=status,proto3,enum=sf.aelf.transform.v1.CallFilter_Status" json:"status,omitempty"`
+}
+
+func (x *CallFilter) Reset() {
+	*x = CallFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_aelf_transform_v1_transforms_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CallFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallFilter) ProtoMessage() {}
+
+func (x *CallFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_aelf_transform_v1_transforms_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallFilter.ProtoReflect.Descriptor instead.
+func (*CallFilter) Descriptor() ([]byte, []int) {
+	return file_sf_aelf_transform_v1_transforms_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CallFilter) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *CallFilter) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+func (x *CallFilter) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *CallFilter) GetStatus() CallFilter_Status {
+	if x != nil {
+		return x.Status
+	}
+	return CallFilter_ANY
+}
+
+// HeaderOnly strips the transaction traces from the block, keeping its header, consensus and cross chain
+// information and statistics, for services following the chain head only. The logs of the consensus
+// transition are stripped too.
+type HeaderOnly struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// When set, the transaction traces are kept reduced to their id, kind and main call execution status.
+	WithTransactionStatuses bool `protobuf:"varint,1,opt,name=with_transaction_statuses,json=withTransactionStatuses,proto3" json:"with_transaction_statuses,omitempty"`
+}
+
+func (x *HeaderOnly) Reset() {
+	*x = HeaderOnly{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_aelf_transform_v1_transforms_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeaderOnly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeaderOnly) ProtoMessage() {}
+
+func (x *HeaderOnly) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_aelf_transform_v1_transforms_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeaderOnly.ProtoReflect.Descriptor instead.
+func (*HeaderOnly) Descriptor() ([]byte, []int) {
+	return file_sf_aelf_transform_v1_transforms_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HeaderOnly) GetWithTransactionStatuses() bool {
+	if x != nil {
+		return x.WithTransactionStatuses
+	}
+	return false
+}
+
+var File_sf_aelf_transform_v1_transforms_proto protoreflect.FileDescriptor
+
+var file_sf_aelf_transform_v1_transforms_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x73, 0x66, 0x2f, 0x61, 0x65, 0x6c, 0x66, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66,
+	0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x22, 0xcb, 0x01,
+	0x0a, 0x0a, 0x43, 0x61, 0x6c, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x73,
+	0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2c, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x00,
+	0x12, 0x0d, 0x0a, 0x09, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x22, 0x48, 0x0a, 0x0a, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x3a, 0x0a, 0x19, 0x77, 0x69, 0x74,
+	0x68, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x77, 0x69,
+	0x74, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x65, 0x73, 0x42, 0x4c, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x66, 0x61, 0x73,
+	0x74, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x68, 0x6f, 0x73, 0x65, 0x2d, 0x61, 0x65, 0x6c, 0x66, 0x2f,
+	0x70, 0x62, 0x2f, 0x73, 0x66, 0x2f, 0x61, 0x65, 0x6c, 0x66, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_sf_aelf_transform_v1_transforms_proto_rawDescOnce sync.Once
+	file_sf_aelf_transform_v1_transforms_proto_rawDescData = file_sf_aelf_transform_v1_transforms_proto_rawDesc
+)
+
+func file_sf_aelf_transform_v1_transforms_proto_rawDescGZIP() []byte {
+	file_sf_aelf_transform_v1_transforms_proto_rawDescOnce.Do(func() {
+		file_sf_aelf_transform_v1_transforms_proto_rawDescData = protoimpl.X.CompressGZIP(file_sf_aelf_transform_v1_transforms_proto_rawDescData)
+	})
+	return file_sf_aelf_transform_v1_transforms_proto_rawDescData
+}
+
+var file_sf_aelf_transform_v1_transforms_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_sf_aelf_transform_v1_transforms_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_sf_aelf_transform_v1_transforms_proto_goTypes = []any{
+	(CallFilter_Status)(0), // 0: sf.aelf.transform.v1.CallFilter.Status
+	(*CallFilter)(nil),     // 1: sf.aelf.transform.v1.CallFilter
+	(*HeaderOnly)(nil),     // 2: sf.aelf.transform.v1.HeaderOnly
+}
+var file_sf_aelf_transform_v1_transforms_proto_depIdxs = []int32{
+	0, // 0: sf.aelf.transform.v1.CallFilter.status:type_name -> sf.aelf.transform.v1.CallFilter.Status
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_sf_aelf_transform_v1_transforms_proto_init() }
+func file_sf_aelf_transform_v1_transforms_proto_init() {
+	if File_sf_aelf_transform_v1_transforms_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_sf_aelf_transform_v1_transforms_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*CallFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_aelf_transform_v1_transforms_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*HeaderOnly); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_sf_aelf_transform_v1_transforms_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_sf_aelf_transform_v1_transforms_proto_goTypes,
+		DependencyIndexes: file_sf_aelf_transform_v1_transforms_proto_depIdxs,
+		EnumInfos:         file_sf_aelf_transform_v1_transforms_proto_enumTypes,
+		MessageInfos:      file_sf_aelf_transform_v1_transforms_proto_msgTypes,
+	}.Build()
+	File_sf_aelf_transform_v1_transforms_proto = out.File
+	file_sf_aelf_transform_v1_transforms_proto_rawDesc = nil
+	file_sf_aelf_transform_v1_transforms_proto_goTypes = nil
+	file_sf_aelf_transform_v1_transforms_proto_depIdxs = nil
+}
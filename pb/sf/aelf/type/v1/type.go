@@ -2,6 +2,7 @@ package pbaelf
 
 import (
 	firecore "github.com/streamingfast/firehose-core"
+	"google.golang.org/protobuf/proto"
 	"time"
 )
 
@@ -30,3 +31,15 @@ func (b *Block) GetFirehoseBlockTime() time.Time {
 func (b *Block) GetFirehoseBlockLIBNum() uint64 {
 	return 1 // TODO: Fix me
 }
+
+// Decode unmarshals the event into message. AElf serializes each indexed field of an event as its own
+// message in `Indexed` and the remaining fields in `NonIndexed`, so all the parts are merged together.
+func (l *LogEvent) Decode(message proto.Message) error {
+	unmarshaller := proto.UnmarshalOptions{Merge: true}
+	for _, indexed := range l.Indexed {
+		if err := unmarshaller.Unmarshal(indexed, message); err != nil {
+			return err
+		}
+	}
+	return unmarshaller.Unmarshal(l.NonIndexed, message)
+}
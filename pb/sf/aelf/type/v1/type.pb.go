@@ -21,6 +21,65 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type TransactionKind int32
+
+const (
+	TransactionKind_USER TransactionKind = 0
+	// Consensus transactions generated by the miner (`UpdateValue`, `UpdateTinyBlockInformation`, `NextRound`, `NextTerm`).
+	TransactionKind_SYSTEM_CONSENSUS TransactionKind = 1
+	// Resource and fee transactions generated by the miner (`DonateResourceToken`, `ClaimTransactionFees`).
+	TransactionKind_SYSTEM_RESOURCE TransactionKind = 2
+	// Cross chain indexing transactions generated by the miner (`ProposeCrossChainIndexing`, `ReleaseCrossChainIndexingProposal`).
+	TransactionKind_SYSTEM_CROSSCHAIN TransactionKind = 3
+	// Other transactions generated by the miner.
+	TransactionKind_SYSTEM_OTHER TransactionKind = 4
+)
+
+// Enum value maps for TransactionKind.
+var (
+	TransactionKind_name = map[int32]string{
+		0: "USER",
+		1: "SYSTEM_CONSENSUS",
+		2: "SYSTEM_RESOURCE",
+		3: "SYSTEM_CROSSCHAIN",
+		4: "SYSTEM_OTHER",
+	}
+	TransactionKind_value = map[string]int32{
+		"USER":              0,
+		"SYSTEM_CONSENSUS":  1,
+		"SYSTEM_RESOURCE":   2,
+		"SYSTEM_CROSSCHAIN": 3,
+		"SYSTEM_OTHER":      4,
+	}
+)
+
+func (x TransactionKind) Enum() *TransactionKind {
+	p := new(TransactionKind)
+	*p = x
+	return p
+}
+
+func (x TransactionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_sf_aelf_type_v1_type_proto_enumTypes[0].Descriptor()
+}
+
+func (TransactionKind) Type() protoreflect.EnumType {
+	return &file_sf_aelf_type_v1_type_proto_enumTypes[0]
+}
+
+func (x TransactionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionKind.Descriptor instead.
+func (TransactionKind) EnumDescriptor() ([]byte, []int) {
+	return file_sf_aelf_type_v1_type_proto_rawDescGZIP(), []int{0}
+}
+
 type ExecutionStatus int32
 
 const (
@@ -28,11 +87,10 @@ const (
 	// Successful =>
 	ExecutionStatus_EXECUTED ExecutionStatus = 1
 	// Failed =>
-	//
-	//	Infrastructure reasons
+	//   Infrastructure reasons
 	ExecutionStatus_CANCELED     ExecutionStatus = -1
 	ExecutionStatus_SYSTEM_ERROR ExecutionStatus = -2
-	// Contract reasons
+	//   Contract reasons
 	ExecutionStatus_CONTRACT_ERROR          ExecutionStatus = -10
 	ExecutionStatus_EXCEEDED_MAX_CALL_DEPTH ExecutionStatus = -11
 	// Pre-failed
@@ -76,11 +134,11 @@ func (x ExecutionStatus) String() string {
 }
 
 func (ExecutionStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_sf_aelf_type_v1_type_proto_enumTypes[0].Descriptor()
+	return file_sf_aelf_type_v1_type_proto_enumTypes[1].Descriptor()
 }
 
 func (ExecutionStatus) Type() protoreflect.EnumType {
-	return &file_sf_aelf_type_v1_type_proto_enumTypes[0]
+	return &file_sf_aelf_type_v1_type_proto_enumTypes[1]
 }
 
 func (x ExecutionStatus) Number() protoreflect.EnumNumber {
@@ -89,7 +147,62 @@ func (x ExecutionStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ExecutionStatus.Descriptor instead.
 func (ExecutionStatus) EnumDescriptor() ([]byte, []int) {
-	return file_sf_aelf_type_v1_type_proto_rawDescGZIP(), []int{0}
+	return file_sf_aelf_type_v1_type_proto_rawDescGZIP(), []int{1}
+}
+
+type ConsensusBehaviour int32
+
+const (
+	ConsensusBehaviour_UPDATE_VALUE ConsensusBehaviour = 0
+	ConsensusBehaviour_NEXT_ROUND   ConsensusBehaviour = 1
+	ConsensusBehaviour_NEXT_TERM    ConsensusBehaviour = 2
+	ConsensusBehaviour_NOTHING      ConsensusBehaviour = 3
+	ConsensusBehaviour_TINY_BLOCK   ConsensusBehaviour = 4
+)
+
+// Enum value maps for ConsensusBehaviour.
+var (
+	ConsensusBehaviour_name = map[int32]string{
+		0: "UPDATE_VALUE",
+		1: "NEXT_ROUND",
+		2: "NEXT_TERM",
+		3: "NOTHING",
+		4: "TINY_BLOCK",
+	}
+	ConsensusBehaviour_value = map[string]int32{
+		"UPDATE_VALUE": 0,
+		"NEXT_ROUND":   1,
+		"NEXT_TERM":    2,
+		"NOTHING":      3,
+		"TINY_BLOCK":   4,
+	}
+)
+
+func (x ConsensusBehaviour) Enum() *ConsensusBehaviour {
+	p := new(ConsensusBehaviour)
+	*p = x
+	return p
+}
+
+func (x ConsensusBehaviour) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConsensusBehaviour) Descriptor() protoreflect.EnumDescriptor {
+	return file_sf_aelf_type_v1_type_proto_enumTypes[2].Descriptor()
+}
+
+func (ConsensusBehaviour) Type() protoreflect.EnumType {
+	return &file_sf_aelf_type_v1_type_proto_enumTypes[2]
+}
+
+func (x ConsensusBehaviour) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConsensusBehaviour.Descriptor instead.
+func (ConsensusBehaviour) EnumDescriptor() ([]byte, []int) {
+	return file_sf_aelf_type_v1_type_proto_rawDescGZIP(), []int{2}
 }
 
 type Block struct {
@@ -97,11 +210,18 @@ type Block struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Output schema version of the block, see the schema versions table of the README. Versions only add
+	// fields, a block converted with an older version has the fields added since then unset.
 	Version           int32               `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 	BlockHash         string              `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 	Height            int64               `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
 	Header            *BlockHeader        `protobuf:"bytes,4,opt,name=header,proto3" json:"header,omitempty"`
 	TransactionTraces []*TransactionTrace `protobuf:"bytes,5,rep,name=transaction_traces,json=transactionTraces,proto3" json:"transaction_traces,omitempty"`
+	// Set when this block starts a new AEDPoS round (or term).
+	ConsensusTransition *ConsensusTransition `protobuf:"bytes,6,opt,name=consensus_transition,json=consensusTransition,proto3" json:"consensus_transition,omitempty"`
+	// The cross chain data indexed or transferred in this block, unset when there is none.
+	CrossChain *CrossChainInfo `protobuf:"bytes,7,opt,name=cross_chain,json=crossChain,proto3" json:"cross_chain,omitempty"`
+	Stats      *BlockStats     `protobuf:"bytes,8,opt,name=stats,proto3" json:"stats,omitempty"`
 }
 
 func (x *Block) Reset() {
@@ -171,22 +291,202 @@ func (x *Block) GetTransactionTraces() []*TransactionTrace {
 	return nil
 }
 
+func (x *Block) GetConsensusTransition() *ConsensusTransition {
+	if x != nil {
+		return x.ConsensusTransition
+	}
+	return nil
+}
+
+func (x *Block) GetCrossChain() *CrossChainInfo {
+	if x != nil {
+		return x.CrossChain
+	}
+	return nil
+}
+
+func (x *Block) GetStats() *BlockStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// Summary statistics of the block, reverted calls are excluded from the log, state and fee counts.
+type BlockStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionCount       int32 `protobuf:"varint,1,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	UserTransactionCount   int32 `protobuf:"varint,2,opt,name=user_transaction_count,json=userTransactionCount,proto3" json:"user_transaction_count,omitempty"`
+	SystemTransactionCount int32 `protobuf:"varint,3,opt,name=system_transaction_count,json=systemTransactionCount,proto3" json:"system_transaction_count,omitempty"`
+	FailedTransactionCount int32 `protobuf:"varint,4,opt,name=failed_transaction_count,json=failedTransactionCount,proto3" json:"failed_transaction_count,omitempty"`
+	// All the calls, reverted ones included.
+	CallCount int32 `protobuf:"varint,5,opt,name=call_count,json=callCount,proto3" json:"call_count,omitempty"`
+	// The deepest call, in levels below its transaction, pre/post plugin transactions being one level below
+	// the transaction they are attached to (`block.CallDepth`).
+	MaxInlineCallDepth int32 `protobuf:"varint,6,opt,name=max_inline_call_depth,json=maxInlineCallDepth,proto3" json:"max_inline_call_depth,omitempty"`
+	LogCount           int32 `protobuf:"varint,7,opt,name=log_count,json=logCount,proto3" json:"log_count,omitempty"`
+	StateWriteCount    int32 `protobuf:"varint,8,opt,name=state_write_count,json=stateWriteCount,proto3" json:"state_write_count,omitempty"`
+	StateDeleteCount   int32 `protobuf:"varint,9,opt,name=state_delete_count,json=stateDeleteCount,proto3" json:"state_delete_count,omitempty"`
+	// The sum of the transactions execution time as reported by the node.
+	TotalElapsed int64 `protobuf:"varint,10,opt,name=total_elapsed,json=totalElapsed,proto3" json:"total_elapsed,omitempty"`
+	// The transaction fees charged (`TransactionFeeCharged` events of the MultiToken contract), by token symbol.
+	FeesBySymbol map[string]int64 `protobuf:"bytes,11,rep,name=fees_by_symbol,json=feesBySymbol,proto3" json:"fees_by_symbol,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// The calls reverted along with a failed transaction or inline call, included in `call_count`.
+	RevertedCallCount int32 `protobuf:"varint,12,opt,name=reverted_call_count,json=revertedCallCount,proto3" json:"reverted_call_count,omitempty"`
+	// The transactions whose `raw_transaction` doesn't hash to their id (`TransactionTrace.raw_transaction_mismatch`).
+	RawTransactionMismatchCount int32 `protobuf:"varint,13,opt,name=raw_transaction_mismatch_count,json=rawTransactionMismatchCount,proto3" json:"raw_transaction_mismatch_count,omitempty"`
+}
+
+func (x *BlockStats) Reset() {
+	*x = BlockStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_aelf_type_v1_type_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockStats) ProtoMessage() {}
+
+func (x *BlockStats) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_aelf_type_v1_type_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockStats.ProtoReflect.Descriptor instead.
+func (*BlockStats) Descriptor() ([]byte, []int) {
+	return file_sf_aelf_type_v1_type_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BlockStats) GetTransactionCount() int32 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
+}
+
+func (x *BlockStats) GetUserTransactionCount() int32 {
+	if x != nil {
+		return x.UserTransactionCount
+	}
+	return 0
+}
+
+func (x *BlockStats) GetSystemTransactionCount() int32 {
+	if x != nil {
+		return x.SystemTransactionCount
+	}
+	return 0
+}
+
+func (x *BlockStats) GetFailedTransactionCount() int32 {
+	if x != nil {
+		return x.FailedTransactionCount
+	}
+	return 0
+}
+
+func (x *BlockStats) GetCallCount() int32 {
+	if x != nil {
+		return x.CallCount
+	}
+	return 0
+}
+
+func (x *BlockStats) GetMaxInlineCallDepth() int32 {
+	if x != nil {
+		return x.MaxInlineCallDepth
+	}
+	return 0
+}
+
+func (x *BlockStats) GetLogCount() int32 {
+	if x != nil {
+		return x.LogCount
+	}
+	return 0
+}
+
+func (x *BlockStats) GetStateWriteCount() int32 {
+	if x != nil {
+		return x.StateWriteCount
+	}
+	return 0
+}
+
+func (x *BlockStats) GetStateDeleteCount() int32 {
+	if x != nil {
+		return x.StateDeleteCount
+	}
+	return 0
+}
+
+func (x *BlockStats) GetTotalElapsed() int64 {
+	if x != nil {
+		return x.TotalElapsed
+	}
+	return 0
+}
+
+func (x *BlockStats) GetFeesBySymbol() map[string]int64 {
+	if x != nil {
+		return x.FeesBySymbol
+	}
+	return nil
+}
+
+func (x *BlockStats) GetRevertedCallCount() int32 {
+	if x != nil {
+		return x.RevertedCallCount
+	}
+	return 0
+}
+
+func (x *BlockStats) GetRawTransactionMismatchCount() int32 {
+	if x != nil {
+		return x.RawTransactionMismatchCount
+	}
+	return 0
+}
+
 type TransactionTrace struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TransactionId  string  `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	RawTransaction []byte  `protobuf:"bytes,2,opt,name=raw_transaction,json=rawTransaction,proto3" json:"raw_transaction,omitempty"`
-	Signature      []byte  `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
-	Calls          []*Call `protobuf:"bytes,4,rep,name=calls,proto3" json:"calls,omitempty"`
-	MainCallIndex  int32   `protobuf:"varint,5,opt,name=main_call_index,json=mainCallIndex,proto3" json:"main_call_index,omitempty"`
+	TransactionId string `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// The serialized `aelf.Transaction`, as emitted by the node, its SHA-256 hash without the `signature` field
+	// (10000) is `transaction_id`. The signature of the sender can be verified against that hash.
+	RawTransaction []byte          `protobuf:"bytes,2,opt,name=raw_transaction,json=rawTransaction,proto3" json:"raw_transaction,omitempty"`
+	Signature      []byte          `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Calls          []*Call         `protobuf:"bytes,4,rep,name=calls,proto3" json:"calls,omitempty"`
+	MainCallIndex  int32           `protobuf:"varint,5,opt,name=main_call_index,json=mainCallIndex,proto3" json:"main_call_index,omitempty"`
+	Kind           TransactionKind `protobuf:"varint,6,opt,name=kind,proto3,enum=sf.aelf.type.v1.TransactionKind" json:"kind,omitempty"`
+	// The execution time of the transaction as reported by the node.
+	Elapsed int64 `protobuf:"varint,7,opt,name=elapsed,proto3" json:"elapsed,omitempty"`
+	// Set when `raw_transaction` doesn't hash to `transaction_id`, the signature of the sender can't be verified
+	// against it. `raw_transaction` is still the original bytes of the node, or the transaction serialized again.
+	RawTransactionMismatch bool `protobuf:"varint,8,opt,name=raw_transaction_mismatch,json=rawTransactionMismatch,proto3" json:"raw_transaction_mismatch,omitempty"`
 }
 
 func (x *TransactionTrace) Reset() {
 	*x = TransactionTrace{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_aelf_type_v1_type_proto_msgTypes[1]
+		mi := &file_sf_aelf_type_v1_type_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -199,7 +499,7 @@ func (x *TransactionTrace) String() string {
 func (*TransactionTrace) ProtoMessage() {}
 
 func (x *TransactionTrace) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_aelf_type_v1_type_proto_msgTypes[1]
+	mi := &file_sf_aelf_type_v1_type_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -212,7 +512,7 @@ func (x *TransactionTrace) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionTrace.ProtoReflect.Descriptor instead.
 func (*TransactionTrace) Descriptor() ([]byte, []int) {
-	return file_sf_aelf_type_v1_type_proto_rawDescGZIP(), []int{1}
+	return file_sf_aelf_type_v1_type_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *TransactionTrace) GetTransactionId() string {
@@ -250,6 +550,27 @@ func (x *TransactionTrace) GetMainCallIndex() int32 {
 	return 0
 }
 
+func (x *TransactionTrace) GetKind() TransactionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return TransactionKind_USER
+}
+
+func (x *TransactionTrace) GetElapsed() int64 {
+	if x != nil {
+		return x.Elapsed
+	}
+	return 0
+}
+
+func (x *TransactionTrace) GetRawTransactionMismatch() bool {
+	if x != nil {
+		return x.RawTransactionMismatch
+	}
+	return false
+}
+
 type Call struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -278,7 +599,7 @@ type Call struct {
 func (x *Call) Reset() {
 	*x = Call{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_aelf_type_v1_type_proto_msgTypes[2]
+		mi := &file_sf_aelf_type_v1_type_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -291,7 +612,7 @@ func (x *Call) String() string {
 func (*Call) ProtoMessage() {}
 
 func (x *Call) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_aelf_type_v1_type_proto_msgTypes[2]
+	mi := &file_sf_aelf_type_v1_type_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -304,7 +625,7 @@ func (x *Call) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Call.ProtoReflect.Descriptor instead.
 func (*Call) Descriptor() ([]byte, []int) {
-	return file_sf_aelf_type_v1_type_proto_rawDescGZIP(), []int{2}
+	return file_sf_aelf_type_v1_type_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Call) GetTransactionId() string {
@@ -421,7 +742,7 @@ type TransactionExecutingStateSet struct {
 func (x *TransactionExecutingStateSet) Reset() {
 	*x = TransactionExecutingStateSet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_aelf_type_v1_type_proto_msgTypes[3]
+		mi := &file_sf_aelf_type_v1_type_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -434,7 +755,7 @@ func (x *TransactionExecutingStateSet) String() string {
 func (*TransactionExecutingStateSet) ProtoMessage() {}
 
 func (x *TransactionExecutingStateSet) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_aelf_type_v1_type_proto_msgTypes[3]
+	mi := &file_sf_aelf_type_v1_type_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -447,7 +768,7 @@ func (x *TransactionExecutingStateSet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionExecutingStateSet.ProtoReflect.Descriptor instead.
 func (*TransactionExecutingStateSet) Descriptor() ([]byte, []int) {
-	return file_sf_aelf_type_v1_type_proto_rawDescGZIP(), []int{3}
+	return file_sf_aelf_type_v1_type_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *TransactionExecutingStateSet) GetWrites() map[string][]byte {
@@ -489,7 +810,7 @@ type LogEvent struct {
 func (x *LogEvent) Reset() {
 	*x = LogEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_aelf_type_v1_type_proto_msgTypes[4]
+		mi := &file_sf_aelf_type_v1_type_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -502,7 +823,7 @@ func (x *LogEvent) String() string {
 func (*LogEvent) ProtoMessage() {}
 
 func (x *LogEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_aelf_type_v1_type_proto_msgTypes[4]
+	mi := &file_sf_aelf_type_v1_type_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -515,7 +836,7 @@ func (x *LogEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogEvent.ProtoReflect.Descriptor instead.
 func (*LogEvent) Descriptor() ([]byte, []int) {
-	return file_sf_aelf_type_v1_type_proto_rawDescGZIP(), []int{4}
+	return file_sf_aelf_type_v1_type_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *LogEvent) GetAddress() string {
@@ -561,14 +882,17 @@ type BlockHeader struct {
 	ExtraData                         map[string][]byte      `protobuf:"bytes,8,rep,name=extra_data,json=extraData,proto3" json:"extra_data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Time                              *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=time,proto3" json:"time,omitempty"`
 	MerkleTreeRootOfTransactionStatus string                 `protobuf:"bytes,10,opt,name=merkle_tree_root_of_transaction_status,json=merkleTreeRootOfTransactionStatus,proto3" json:"merkle_tree_root_of_transaction_status,omitempty"`
-	SignerPubkey                      []byte                 `protobuf:"bytes,9999,opt,name=signer_pubkey,json=signerPubkey,proto3" json:"signer_pubkey,omitempty"`
-	Signature                         []byte                 `protobuf:"bytes,10000,opt,name=signature,proto3" json:"signature,omitempty"`
+	// The decoded AEDPoS consensus information found under the `Consensus` key of `extra_data`, unset
+	// when the block carries none.
+	Consensus    *ConsensusInfo `protobuf:"bytes,11,opt,name=consensus,proto3" json:"consensus,omitempty"`
+	SignerPubkey []byte         `protobuf:"bytes,9999,opt,name=signer_pubkey,json=signerPubkey,proto3" json:"signer_pubkey,omitempty"`
+	Signature    []byte         `protobuf:"bytes,10000,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
 func (x *BlockHeader) Reset() {
 	*x = BlockHeader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sf_aelf_type_v1_type_proto_msgTypes[5]
+		mi := &file_sf_aelf_type_v1_type_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -581,7 +905,7 @@ func (x *BlockHeader) String() string {
 func (*BlockHeader) ProtoMessage() {}
 
 func (x *BlockHeader) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_aelf_type_v1_type_proto_msgTypes[5]
+	mi := &file_sf_aelf_type_v1_type_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,7 +918,7 @@ func (x *BlockHeader) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockHeader.ProtoReflect.Descriptor instead.
 func (*BlockHeader) Descriptor() ([]byte, []int) {
-	return file_sf_aelf_type_v1_type_proto_rawDescGZIP(), []int{5}
+	return file_sf_aelf_type_v1_type_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *BlockHeader) GetVersion() int32 {
@@ -667,6 +991,13 @@ func (x *BlockHeader) GetMerkleTreeRootOfTransactionStatus() string {
 	return ""
 }
 
+func (x *BlockHeader) GetConsensus() *ConsensusInfo {
+	if x != nil {
+		return x.Consensus
+	}
+	return nil
+}
+
 func (x *BlockHeader) GetSignerPubkey() []byte {
 	if x != nil {
 		return x.SignerPubkey
@@ -681,217 +1012,1467 @@ func (x *BlockHeader) GetSignature() []byte {
 	return nil
 }
 
-var File_sf_aelf_type_v1_type_proto protoreflect.FileDescriptor
+type ConsensusInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_sf_aelf_type_v1_type_proto_rawDesc = []byte{
-	0x0a, 0x1a, 0x73, 0x66, 0x2f, 0x61, 0x65, 0x6c, 0x66, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x76,
-	0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x73, 0x66,
-	0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe0,
-	0x01, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x66, 0x2e, 0x61,
-	0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
-	0x50, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
-	0x72, 0x61, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x66,
-	0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x11,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x63, 0x65,
-	0x73, 0x22, 0xd5, 0x01, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a,
-	0x0f, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x72, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x61, 0x6c, 0x6c,
-	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x69, 0x6e,
-	0x43, 0x61, 0x6c, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x9d, 0x04, 0x0a, 0x04, 0x43, 0x61,
-	0x6c, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x6c,
-	0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61,
-	0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x66, 0x5f, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0e, 0x72, 0x65, 0x66, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x66, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x72,
-	0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
-	0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e,
-	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1f,
-	0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4b, 0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x20, 0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x4a, 0x0a,
-	0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2d, 0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x52,
-	0x08, 0x73, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x6c, 0x6f, 0x67,
-	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c,
-	0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x72,
-	0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69,
-	0x73, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x22, 0xc8, 0x03, 0x0a, 0x1c, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x12, 0x51, 0x0a, 0x06, 0x77, 0x72,
-	0x69, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x73, 0x66, 0x2e,
-	0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e,
-	0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x4e, 0x0a,
-	0x05, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x73,
-	0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x2e, 0x52, 0x65, 0x61, 0x64,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x54, 0x0a,
-	0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a,
-	0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x38,
-	0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0x73, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52,
-	0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x6e, 0x5f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6e,
-	0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x22, 0xff, 0x04, 0x0a, 0x0b, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x2e,
-	0x0a, 0x13, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x65,
-	0x76, 0x69, 0x6f, 0x75, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x46,
-	0x0a, 0x20, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x6f,
-	0x6f, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1c, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65,
-	0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x4f, 0x66, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x43, 0x0a, 0x1f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65,
-	0x5f, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x77, 0x6f,
-	0x72, 0x6c, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x1a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x4f,
-	0x66, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62,
-	0x6c, 0x6f, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x6f,
-	0x6d, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x4a, 0x0a, 0x0a, 0x65, 0x78, 0x74,
-	0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
-	0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x74, 0x72,
-	0x61, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72,
-	0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x51, 0x0a, 0x26, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f,
-	0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x21, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x54, 0x72, 0x65,
-	0x65, 0x52, 0x6f, 0x6f, 0x74, 0x4f, 0x66, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x8f, 0x4e, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1d,
-	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x90, 0x4e, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x3c, 0x0a,
-	0x0e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a, 0xd4, 0x01, 0x0a, 0x0f,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c,
-	0x0a, 0x08, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x08,
-	0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
-	0xff, 0xff, 0x01, 0x12, 0x19, 0x0a, 0x0c, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x45, 0x52,
-	0x52, 0x4f, 0x52, 0x10, 0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x1b,
-	0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
-	0x10, 0xf6, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x24, 0x0a, 0x17, 0x45,
-	0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x43, 0x41, 0x4c, 0x4c,
-	0x5f, 0x44, 0x45, 0x50, 0x54, 0x48, 0x10, 0xf5, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
-	0x01, 0x12, 0x16, 0x0a, 0x09, 0x50, 0x52, 0x45, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x9d,
-	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x17, 0x0a, 0x0a, 0x50, 0x4f, 0x53,
-	0x54, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0xb9, 0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
-	0xff, 0x01, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x66, 0x61, 0x73, 0x74, 0x2f, 0x66,
-	0x69, 0x72, 0x65, 0x68, 0x6f, 0x73, 0x65, 0x2d, 0x61, 0x65, 0x6c, 0x66, 0x2f, 0x70, 0x62, 0x2f,
-	0x73, 0x66, 0x2f, 0x61, 0x65, 0x6c, 0x66, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x76, 0x31, 0x3b,
-	0x70, 0x62, 0x61, 0x65, 0x6c, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	// The public key of the miner that produced the consensus information, hex encoded.
+	SenderPubkey string             `protobuf:"bytes,1,opt,name=sender_pubkey,json=senderPubkey,proto3" json:"sender_pubkey,omitempty"`
+	Behaviour    ConsensusBehaviour `protobuf:"varint,2,opt,name=behaviour,proto3,enum=sf.aelf.type.v1.ConsensusBehaviour" json:"behaviour,omitempty"`
+	RoundNumber  int64              `protobuf:"varint,3,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
+	TermNumber   int64              `protobuf:"varint,4,opt,name=term_number,json=termNumber,proto3" json:"term_number,omitempty"`
+	// The miners of the round, sorted by mining order.
+	Miners []*Miner `protobuf:"bytes,5,rep,name=miners,proto3" json:"miners,omitempty"`
+	// The public key of the extra block producer of the round.
+	ExtraBlockProducer string `protobuf:"bytes,6,opt,name=extra_block_producer,json=extraBlockProducer,proto3" json:"extra_block_producer,omitempty"`
+	// The public key of the miner that produced the extra block in the previous round.
+	ExtraBlockProducerOfPreviousRound     string `protobuf:"bytes,7,opt,name=extra_block_producer_of_previous_round,json=extraBlockProducerOfPreviousRound,proto3" json:"extra_block_producer_of_previous_round,omitempty"`
+	ConfirmedIrreversibleBlockHeight      int64  `protobuf:"varint,8,opt,name=confirmed_irreversible_block_height,json=confirmedIrreversibleBlockHeight,proto3" json:"confirmed_irreversible_block_height,omitempty"`
+	ConfirmedIrreversibleBlockRoundNumber int64  `protobuf:"varint,9,opt,name=confirmed_irreversible_block_round_number,json=confirmedIrreversibleBlockRoundNumber,proto3" json:"confirmed_irreversible_block_round_number,omitempty"`
+	IsMinerListJustChanged                bool   `protobuf:"varint,10,opt,name=is_miner_list_just_changed,json=isMinerListJustChanged,proto3" json:"is_miner_list_just_changed,omitempty"`
+	MainChainMinersRoundNumber            int64  `protobuf:"varint,11,opt,name=main_chain_miners_round_number,json=mainChainMinersRoundNumber,proto3" json:"main_chain_miners_round_number,omitempty"`
+	// The time from chain start to current round (seconds).
+	BlockchainAge        int64 `protobuf:"varint,12,opt,name=blockchain_age,json=blockchainAge,proto3" json:"blockchain_age,omitempty"`
+	RoundIdForValidation int64 `protobuf:"varint,13,opt,name=round_id_for_validation,json=roundIdForValidation,proto3" json:"round_id_for_validation,omitempty"`
+}
+
+func (x *ConsensusInfo) Reset() {
+	*x = ConsensusInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_aelf_type_v1_type_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var (
-	file_sf_aelf_type_v1_type_proto_rawDescOnce sync.Once
-	file_sf_aelf_type_v1_type_proto_rawDescData = file_sf_aelf_type_v1_type_proto_rawDesc
-)
+func (x *ConsensusInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_sf_aelf_type_v1_type_proto_rawDescGZIP() []byte {
-	file_sf_aelf_type_v1_type_proto_rawDescOnce.Do(func() {
-		file_sf_aelf_type_v1_type_proto_rawDescData = protoimpl.X.CompressGZIP(file_sf_aelf_type_v1_type_proto_rawDescData)
-	})
+func (*ConsensusInfo) ProtoMessage() {}
+
+func (x *ConsensusInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_aelf_type_v1_type_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsensusInfo.ProtoReflect.Descriptor instead.
+func (*ConsensusInfo) Descriptor() ([]byte, []int) {
+	return file_sf_aelf_type_v1_type_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ConsensusInfo) GetSenderPubkey() string {
+	if x != nil {
+		return x.SenderPubkey
+	}
+	return ""
+}
+
+func (x *ConsensusInfo) GetBehaviour() ConsensusBehaviour {
+	if x != nil {
+		return x.Behaviour
+	}
+	return ConsensusBehaviour_UPDATE_VALUE
+}
+
+func (x *ConsensusInfo) GetRoundNumber() int64 {
+	if x != nil {
+		return x.RoundNumber
+	}
+	return 0
+}
+
+func (x *ConsensusInfo) GetTermNumber() int64 {
+	if x != nil {
+		return x.TermNumber
+	}
+	return 0
+}
+
+func (x *ConsensusInfo) GetMiners() []*Miner {
+	if x != nil {
+		return x.Miners
+	}
+	return nil
+}
+
+func (x *ConsensusInfo) GetExtraBlockProducer() string {
+	if x != nil {
+		return x.ExtraBlockProducer
+	}
+	return ""
+}
+
+func (x *ConsensusInfo) GetExtraBlockProducerOfPreviousRound() string {
+	if x != nil {
+		return x.ExtraBlockProducerOfPreviousRound
+	}
+	return ""
+}
+
+func (x *ConsensusInfo) GetConfirmedIrreversibleBlockHeight() int64 {
+	if x != nil {
+		return x.ConfirmedIrreversibleBlockHeight
+	}
+	return 0
+}
+
+func (x *ConsensusInfo) GetConfirmedIrreversibleBlockRoundNumber() int64 {
+	if x != nil {
+		return x.ConfirmedIrreversibleBlockRoundNumber
+	}
+	return 0
+}
+
+func (x *ConsensusInfo) GetIsMinerListJustChanged() bool {
+	if x != nil {
+		return x.IsMinerListJustChanged
+	}
+	return false
+}
+
+func (x *ConsensusInfo) GetMainChainMinersRoundNumber() int64 {
+	if x != nil {
+		return x.MainChainMinersRoundNumber
+	}
+	return 0
+}
+
+func (x *ConsensusInfo) GetBlockchainAge() int64 {
+	if x != nil {
+		return x.BlockchainAge
+	}
+	return 0
+}
+
+func (x *ConsensusInfo) GetRoundIdForValidation() int64 {
+	if x != nil {
+		return x.RoundIdForValidation
+	}
+	return 0
+}
+
+type ConsensusTransition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the block also starts a new term, a new term always starts a new round.
+	IsNewTerm   bool  `protobuf:"varint,1,opt,name=is_new_term,json=isNewTerm,proto3" json:"is_new_term,omitempty"`
+	RoundNumber int64 `protobuf:"varint,2,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
+	TermNumber  int64 `protobuf:"varint,3,opt,name=term_number,json=termNumber,proto3" json:"term_number,omitempty"`
+	// The miners of the new round, sorted by mining order.
+	Miners                            []*Miner `protobuf:"bytes,4,rep,name=miners,proto3" json:"miners,omitempty"`
+	IsMinerListJustChanged            bool     `protobuf:"varint,5,opt,name=is_miner_list_just_changed,json=isMinerListJustChanged,proto3" json:"is_miner_list_just_changed,omitempty"`
+	ExtraBlockProducerOfPreviousRound string   `protobuf:"bytes,6,opt,name=extra_block_producer_of_previous_round,json=extraBlockProducerOfPreviousRound,proto3" json:"extra_block_producer_of_previous_round,omitempty"`
+	// The id of the `NextRound`/`NextTerm` system transaction, empty if it was not found in the block.
+	TransactionId string `protobuf:"bytes,7,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// The log events emitted by the consensus contract in the `NextRound`/`NextTerm` transaction.
+	Logs []*LogEvent `protobuf:"bytes,8,rep,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (x *ConsensusTransition) Reset() {
+	*x = ConsensusTransition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_aelf_type_v1_type_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsensusTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsensusTransition) ProtoMessage() {}
+
+func (x *ConsensusTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_aelf_type_v1_type_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsensusTransition.ProtoReflect.Descriptor instead.
+func (*ConsensusTransition) Descriptor() ([]byte, []int) {
+	return file_sf_aelf_type_v1_type_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ConsensusTransition) GetIsNewTerm() bool {
+	if x != nil {
+		return x.IsNewTerm
+	}
+	return false
+}
+
+func (x *ConsensusTransition) GetRoundNumber() int64 {
+	if x != nil {
+		return x.RoundNumber
+	}
+	return 0
+}
+
+func (x *ConsensusTransition) GetTermNumber() int64 {
+	if x != nil {
+		return x.TermNumber
+	}
+	return 0
+}
+
+func (x *ConsensusTransition) GetMiners() []*Miner {
+	if x != nil {
+		return x.Miners
+	}
+	return nil
+}
+
+func (x *ConsensusTransition) GetIsMinerListJustChanged() bool {
+	if x != nil {
+		return x.IsMinerListJustChanged
+	}
+	return false
+}
+
+func (x *ConsensusTransition) GetExtraBlockProducerOfPreviousRound() string {
+	if x != nil {
+		return x.ExtraBlockProducerOfPreviousRound
+	}
+	return ""
+}
+
+func (x *ConsensusTransition) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *ConsensusTransition) GetLogs() []*LogEvent {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+type CrossChainInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The merkle tree root of the indexed side chain transaction status roots, from the `CrossChain` key
+	// of the header `extra_data`.
+	TransactionStatusMerkleTreeRoot string `protobuf:"bytes,1,opt,name=transaction_status_merkle_tree_root,json=transactionStatusMerkleTreeRoot,proto3" json:"transaction_status_merkle_tree_root,omitempty"`
+	// The side chain blocks indexed by the CrossChain contract in this block, when an indexing proposal is released
+	// (`RecordCrossChainData`). Proposals, which may still be rejected, are left out.
+	SideChainBlockData []*SideChainBlockData `protobuf:"bytes,2,rep,name=side_chain_block_data,json=sideChainBlockData,proto3" json:"side_chain_block_data,omitempty"`
+	// The parent chain blocks indexed by the CrossChain contract in this block, when an indexing proposal is released.
+	ParentChainBlockData []*ParentChainBlockData `protobuf:"bytes,3,rep,name=parent_chain_block_data,json=parentChainBlockData,proto3" json:"parent_chain_block_data,omitempty"`
+	// The `CrossChainTransferred` events emitted by the MultiToken contract in this block.
+	Transfers []*CrossChainTransfer `protobuf:"bytes,4,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	// The `CrossChainReceived` events emitted by the MultiToken contract in this block.
+	Receives []*CrossChainReceive `protobuf:"bytes,5,rep,name=receives,proto3" json:"receives,omitempty"`
+}
+
+func (x *CrossChainInfo) Reset() {
+	*x = CrossChainInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_aelf_type_v1_type_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrossChainInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrossChainInfo) ProtoMessage() {}
+
+func (x *CrossChainInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_aelf_type_v1_type_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrossChainInfo.ProtoReflect.Descriptor instead.
+func (*CrossChainInfo) Descriptor() ([]byte, []int) {
+	return file_sf_aelf_type_v1_type_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CrossChainInfo) GetTransactionStatusMerkleTreeRoot() string {
+	if x != nil {
+		return x.TransactionStatusMerkleTreeRoot
+	}
+	return ""
+}
+
+func (x *CrossChainInfo) GetSideChainBlockData() []*SideChainBlockData {
+	if x != nil {
+		return x.SideChainBlockData
+	}
+	return nil
+}
+
+func (x *CrossChainInfo) GetParentChainBlockData() []*ParentChainBlockData {
+	if x != nil {
+		return x.ParentChainBlockData
+	}
+	return nil
+}
+
+func (x *CrossChainInfo) GetTransfers() []*CrossChainTransfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+func (x *CrossChainInfo) GetReceives() []*CrossChainReceive {
+	if x != nil {
+		return x.Receives
+	}
+	return nil
+}
+
+type SideChainBlockData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the indexing transaction.
+	TransactionId                   string `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	ChainId                         int32  `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Height                          int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	BlockHeaderHash                 string `protobuf:"bytes,4,opt,name=block_header_hash,json=blockHeaderHash,proto3" json:"block_header_hash,omitempty"`
+	TransactionStatusMerkleTreeRoot string `protobuf:"bytes,5,opt,name=transaction_status_merkle_tree_root,json=transactionStatusMerkleTreeRoot,proto3" json:"transaction_status_merkle_tree_root,omitempty"`
+}
+
+func (x *SideChainBlockData) Reset() {
+	*x = SideChainBlockData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_aelf_type_v1_type_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SideChainBlockData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SideChainBlockData) ProtoMessage() {}
+
+func (x *SideChainBlockData) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_aelf_type_v1_type_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SideChainBlockData.ProtoReflect.Descriptor instead.
+func (*SideChainBlockData) Descriptor() ([]byte, []int) {
+	return file_sf_aelf_type_v1_type_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SideChainBlockData) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *SideChainBlockData) GetChainId() int32 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *SideChainBlockData) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *SideChainBlockData) GetBlockHeaderHash() string {
+	if x != nil {
+		return x.BlockHeaderHash
+	}
+	return ""
+}
+
+func (x *SideChainBlockData) GetTransactionStatusMerkleTreeRoot() string {
+	if x != nil {
+		return x.TransactionStatusMerkleTreeRoot
+	}
+	return ""
+}
+
+type ParentChainBlockData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the indexing transaction.
+	TransactionId                   string `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	ChainId                         int32  `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Height                          int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	TransactionStatusMerkleTreeRoot string `protobuf:"bytes,4,opt,name=transaction_status_merkle_tree_root,json=transactionStatusMerkleTreeRoot,proto3" json:"transaction_status_merkle_tree_root,omitempty"`
+	// The merkle tree root of the side chain transaction status roots indexed by the parent chain block.
+	CrossChainTransactionStatusMerkleTreeRoot string            `protobuf:"bytes,5,opt,name=cross_chain_transaction_status_merkle_tree_root,json=crossChainTransactionStatusMerkleTreeRoot,proto3" json:"cross_chain_transaction_status_merkle_tree_root,omitempty"`
+	ExtraData                                 map[string][]byte `protobuf:"bytes,6,rep,name=extra_data,json=extraData,proto3" json:"extra_data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ParentChainBlockData) Reset() {
+	*x = ParentChainBlockData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_aelf_type_v1_type_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParentChainBlockData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParentChainBlockData) ProtoMessage() {}
+
+func (x *ParentChainBlockData) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_aelf_type_v1_type_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParentChainBlockData.ProtoReflect.Descriptor instead.
+func (*ParentChainBlockData) Descriptor() ([]byte, []int) {
+	return file_sf_aelf_type_v1_type_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ParentChainBlockData) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *ParentChainBlockData) GetChainId() int32 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *ParentChainBlockData) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ParentChainBlockData) GetTransactionStatusMerkleTreeRoot() string {
+	if x != nil {
+		return x.TransactionStatusMerkleTreeRoot
+	}
+	return ""
+}
+
+func (x *ParentChainBlockData) GetCrossChainTransactionStatusMerkleTreeRoot() string {
+	if x != nil {
+		return x.CrossChainTransactionStatusMerkleTreeRoot
+	}
+	return ""
+}
+
+func (x *ParentChainBlockData) GetExtraData() map[string][]byte {
+	if x != nil {
+		return x.ExtraData
+	}
+	return nil
+}
+
+type CrossChainTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionId string `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	CallPath      string `protobuf:"bytes,2,opt,name=call_path,json=callPath,proto3" json:"call_path,omitempty"`
+	From          string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Symbol        string `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Amount        int64  `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Memo          string `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
+	ToChainId     int32  `protobuf:"varint,8,opt,name=to_chain_id,json=toChainId,proto3" json:"to_chain_id,omitempty"`
+	IssueChainId  int32  `protobuf:"varint,9,opt,name=issue_chain_id,json=issueChainId,proto3" json:"issue_chain_id,omitempty"`
+}
+
+func (x *CrossChainTransfer) Reset() {
+	*x = CrossChainTransfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_aelf_type_v1_type_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrossChainTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrossChainTransfer) ProtoMessage() {}
+
+func (x *CrossChainTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_aelf_type_v1_type_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrossChainTransfer.ProtoReflect.Descriptor instead.
+func (*CrossChainTransfer) Descriptor() ([]byte, []int) {
+	return file_sf_aelf_type_v1_type_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CrossChainTransfer) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *CrossChainTransfer) GetCallPath() string {
+	if x != nil {
+		return x.CallPath
+	}
+	return ""
+}
+
+func (x *CrossChainTransfer) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *CrossChainTransfer) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *CrossChainTransfer) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *CrossChainTransfer) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CrossChainTransfer) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *CrossChainTransfer) GetToChainId() int32 {
+	if x != nil {
+		return x.ToChainId
+	}
+	return 0
+}
+
+func (x *CrossChainTransfer) GetIssueChainId() int32 {
+	if x != nil {
+		return x.IssueChainId
+	}
+	return 0
+}
+
+type CrossChainReceive struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionId     string `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	CallPath          string `protobuf:"bytes,2,opt,name=call_path,json=callPath,proto3" json:"call_path,omitempty"`
+	From              string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To                string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Symbol            string `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Amount            int64  `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Memo              string `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
+	FromChainId       int32  `protobuf:"varint,8,opt,name=from_chain_id,json=fromChainId,proto3" json:"from_chain_id,omitempty"`
+	IssueChainId      int32  `protobuf:"varint,9,opt,name=issue_chain_id,json=issueChainId,proto3" json:"issue_chain_id,omitempty"`
+	ParentChainHeight int64  `protobuf:"varint,10,opt,name=parent_chain_height,json=parentChainHeight,proto3" json:"parent_chain_height,omitempty"`
+	// The id of the `CrossChainTransfer` transaction on the source chain.
+	TransferTransactionId string `protobuf:"bytes,11,opt,name=transfer_transaction_id,json=transferTransactionId,proto3" json:"transfer_transaction_id,omitempty"`
+}
+
+func (x *CrossChainReceive) Reset() {
+	*x = CrossChainReceive{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_aelf_type_v1_type_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrossChainReceive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrossChainReceive) ProtoMessage() {}
+
+func (x *CrossChainReceive) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_aelf_type_v1_type_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrossChainReceive.ProtoReflect.Descriptor instead.
+func (*CrossChainReceive) Descriptor() ([]byte, []int) {
+	return file_sf_aelf_type_v1_type_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CrossChainReceive) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *CrossChainReceive) GetCallPath() string {
+	if x != nil {
+		return x.CallPath
+	}
+	return ""
+}
+
+func (x *CrossChainReceive) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *CrossChainReceive) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *CrossChainReceive) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *CrossChainReceive) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CrossChainReceive) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *CrossChainReceive) GetFromChainId() int32 {
+	if x != nil {
+		return x.FromChainId
+	}
+	return 0
+}
+
+func (x *CrossChainReceive) GetIssueChainId() int32 {
+	if x != nil {
+		return x.IssueChainId
+	}
+	return 0
+}
+
+func (x *CrossChainReceive) GetParentChainHeight() int64 {
+	if x != nil {
+		return x.ParentChainHeight
+	}
+	return 0
+}
+
+func (x *CrossChainReceive) GetTransferTransactionId() string {
+	if x != nil {
+		return x.TransferTransactionId
+	}
+	return ""
+}
+
+type Miner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The public key of the miner, hex encoded.
+	Pubkey                         string                   `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Order                          int32                    `protobuf:"varint,2,opt,name=order,proto3" json:"order,omitempty"`
+	IsExtraBlockProducer           bool                     `protobuf:"varint,3,opt,name=is_extra_block_producer,json=isExtraBlockProducer,proto3" json:"is_extra_block_producer,omitempty"`
+	InValue                        string                   `protobuf:"bytes,4,opt,name=in_value,json=inValue,proto3" json:"in_value,omitempty"`
+	OutValue                       string                   `protobuf:"bytes,5,opt,name=out_value,json=outValue,proto3" json:"out_value,omitempty"`
+	Signature                      string                   `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	PreviousInValue                string                   `protobuf:"bytes,7,opt,name=previous_in_value,json=previousInValue,proto3" json:"previous_in_value,omitempty"`
+	ExpectedMiningTime             *timestamppb.Timestamp   `protobuf:"bytes,8,opt,name=expected_mining_time,json=expectedMiningTime,proto3" json:"expected_mining_time,omitempty"`
+	ActualMiningTimes              []*timestamppb.Timestamp `protobuf:"bytes,9,rep,name=actual_mining_times,json=actualMiningTimes,proto3" json:"actual_mining_times,omitempty"`
+	ProducedBlocks                 int64                    `protobuf:"varint,10,opt,name=produced_blocks,json=producedBlocks,proto3" json:"produced_blocks,omitempty"`
+	ProducedTinyBlocks             int64                    `protobuf:"varint,11,opt,name=produced_tiny_blocks,json=producedTinyBlocks,proto3" json:"produced_tiny_blocks,omitempty"`
+	MissedTimeSlots                int64                    `protobuf:"varint,12,opt,name=missed_time_slots,json=missedTimeSlots,proto3" json:"missed_time_slots,omitempty"`
+	SupposedOrderOfNextRound       int32                    `protobuf:"varint,13,opt,name=supposed_order_of_next_round,json=supposedOrderOfNextRound,proto3" json:"supposed_order_of_next_round,omitempty"`
+	FinalOrderOfNextRound          int32                    `protobuf:"varint,14,opt,name=final_order_of_next_round,json=finalOrderOfNextRound,proto3" json:"final_order_of_next_round,omitempty"`
+	ImpliedIrreversibleBlockHeight int64                    `protobuf:"varint,15,opt,name=implied_irreversible_block_height,json=impliedIrreversibleBlockHeight,proto3" json:"implied_irreversible_block_height,omitempty"`
+}
+
+func (x *Miner) Reset() {
+	*x = Miner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sf_aelf_type_v1_type_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Miner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Miner) ProtoMessage() {}
+
+func (x *Miner) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_aelf_type_v1_type_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Miner.ProtoReflect.Descriptor instead.
+func (*Miner) Descriptor() ([]byte, []int) {
+	return file_sf_aelf_type_v1_type_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Miner) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *Miner) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *Miner) GetIsExtraBlockProducer() bool {
+	if x != nil {
+		return x.IsExtraBlockProducer
+	}
+	return false
+}
+
+func (x *Miner) GetInValue() string {
+	if x != nil {
+		return x.InValue
+	}
+	return ""
+}
+
+func (x *Miner) GetOutValue() string {
+	if x != nil {
+		return x.OutValue
+	}
+	return ""
+}
+
+func (x *Miner) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *Miner) GetPreviousInValue() string {
+	if x != nil {
+		return x.PreviousInValue
+	}
+	return ""
+}
+
+func (x *Miner) GetExpectedMiningTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedMiningTime
+	}
+	return nil
+}
+
+func (x *Miner) GetActualMiningTimes() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.ActualMiningTimes
+	}
+	return nil
+}
+
+func (x *Miner) GetProducedBlocks() int64 {
+	if x != nil {
+		return x.ProducedBlocks
+	}
+	return 0
+}
+
+func (x *Miner) GetProducedTinyBlocks() int64 {
+	if x != nil {
+		return x.ProducedTinyBlocks
+	}
+	return 0
+}
+
+func (x *Miner) GetMissedTimeSlots() int64 {
+	if x != nil {
+		return x.MissedTimeSlots
+	}
+	return 0
+}
+
+func (x *Miner) GetSupposedOrderOfNextRound() int32 {
+	if x != nil {
+		return x.SupposedOrderOfNextRound
+	}
+	return 0
+}
+
+func (x *Miner) GetFinalOrderOfNextRound() int32 {
+	if x != nil {
+		return x.FinalOrderOfNextRound
+	}
+	return 0
+}
+
+func (x *Miner) GetImpliedIrreversibleBlockHeight() int64 {
+	if x != nil {
+		return x.ImpliedIrreversibleBlockHeight
+	}
+	return 0
+}
+
+var File_sf_aelf_type_v1_type_proto protoreflect.FileDescriptor
+
+var file_sf_aelf_type_v1_type_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x73, 0x66, 0x2f, 0x61, 0x65, 0x6c, 0x66, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x76,
+	0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x73, 0x66,
+	0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xae,
+	0x03, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x66, 0x2e, 0x61,
+	0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x50, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x66,
+	0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x11,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x63, 0x65,
+	0x73, 0x12, 0x57, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0b, 0x63, 0x72,
+	0x6f, 0x73, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0a, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x31, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x66,
+	0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22,
+	0xdc, 0x05, 0x0a, 0x0a, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x2b,
+	0x0a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x75, 0x73, 0x65,
+	0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x38, 0x0a, 0x18, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x16, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x6c, 0x69,
+	0x6e, 0x65, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x61,
+	0x6c, 0x6c, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x6c, 0x61, 0x70,
+	0x73, 0x65, 0x64, 0x12, 0x53, 0x0a, 0x0e, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x66,
+	0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x46, 0x65, 0x65, 0x73, 0x42, 0x79, 0x53,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x66, 0x65, 0x65, 0x73,
+	0x42, 0x79, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x43,
+	0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x1e, 0x72, 0x61, 0x77, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x73, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x1b, 0x72, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x3f, 0x0a,
+	0x11, 0x46, 0x65, 0x65, 0x73, 0x42, 0x79, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xdf,
+	0x02, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72,
+	0x61, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x61,
+	0x77, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0e, 0x72, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x2b, 0x0a, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x61, 0x6c,
+	0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x34, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65,
+	0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x72, 0x61, 0x77, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x22, 0x9d, 0x04, 0x0a, 0x04, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a,
+	0x10, 0x72, 0x65, 0x66, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x66, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4b,
+	0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65,
+	0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0f, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x4a, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x65,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c,
+	0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74,
+	0x12, 0x2d, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x6f, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64,
+	0x22, 0xc8, 0x03, 0x0a, 0x1c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x74, 0x12, 0x51, 0x0a, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x39, 0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74,
+	0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x74, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x12, 0x54, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x38, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x3a, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x73, 0x0a, 0x08, 0x4c,
+	0x6f, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64,
+	0x22, 0xbd, 0x05, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x46, 0x0a, 0x20, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f,
+	0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x1c, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x4f,
+	0x66, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x43, 0x0a,
+	0x1f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x54, 0x72,
+	0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x4f, 0x66, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x4a, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x51, 0x0a, 0x26,
+	0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x5f, 0x6f, 0x66, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x21, 0x6d, 0x65,
+	0x72, 0x6b, 0x6c, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x4f, 0x66, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x3c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x12, 0x24, 0x0a,
+	0x0d, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x8f,
+	0x4e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x90, 0x4e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x1a, 0x3c, 0x0a, 0x0e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xf7, 0x05, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x41, 0x0a, 0x09, 0x62, 0x65, 0x68, 0x61, 0x76,
+	0x69, 0x6f, 0x75, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x73, 0x66, 0x2e,
+	0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x75, 0x72, 0x52,
+	0x09, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x75, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2e,
+	0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x30,
+	0x0a, 0x14, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72,
+	0x12, 0x51, 0x0a, 0x26, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x21, 0x65, 0x78, 0x74, 0x72, 0x61, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x65, 0x72, 0x4f, 0x66, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x4d, 0x0a, 0x23, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64,
+	0x5f, 0x69, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x49, 0x72, 0x72, 0x65, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x58, 0x0a, 0x29, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f,
+	0x69, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x25, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64,
+	0x49, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x1a,
+	0x69, 0x73, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6a, 0x75,
+	0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x16, 0x69, 0x73, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x75, 0x73,
+	0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x42, 0x0a, 0x1e, 0x6d, 0x61, 0x69, 0x6e,
+	0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x5f, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x1a, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x65, 0x72,
+	0x73, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x41, 0x67, 0x65, 0x12, 0x35, 0x0a, 0x17, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x5f,
+	0x66, 0x6f, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x46, 0x6f, 0x72,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8e, 0x03, 0x0a, 0x13, 0x43,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x6e, 0x65, 0x77, 0x5f, 0x74, 0x65, 0x72,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x4e, 0x65, 0x77, 0x54, 0x65,
+	0x72, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x65, 0x72, 0x6d,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x06,
+	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x1a, 0x69, 0x73, 0x5f, 0x6d, 0x69, 0x6e,
+	0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6a, 0x75, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x69, 0x73, 0x4d, 0x69,
+	0x6e, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x75, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x12, 0x51, 0x0a, 0x26, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x21, 0x65, 0x78, 0x74, 0x72, 0x61, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x4f, 0x66, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x04,
+	0x6c, 0x6f, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x66, 0x2e,
+	0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0x97, 0x03, 0x0a, 0x0e,
+	0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4c,
+	0x0a, 0x23, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x65, 0x65,
+	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65,
+	0x72, 0x6b, 0x6c, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x56, 0x0a, 0x15,
+	0x73, 0x69, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x66,
+	0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69,
+	0x64, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x12, 0x73, 0x69, 0x64, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x5c, 0x0a, 0x17, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x52, 0x14, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x41, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c, 0x66, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x3e, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x66, 0x2e, 0x61, 0x65, 0x6c,
+	0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x08, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x73, 0x22, 0xe8, 0x01, 0x0a, 0x12, 0x53, 0x69, 0x64, 0x65, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x4c, 0x0a, 0x23, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f,
+	0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x1f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74,
+	0x22, 0xb5, 0x03, 0x0a, 0x14, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x4c, 0x0a, 0x23, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65,
+	0x5f, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x1f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f,
+	0x74, 0x12, 0x62, 0x0a, 0x2f, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x29, 0x63, 0x72, 0x6f, 0x73,
+	0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x54, 0x72, 0x65,
+	0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x53, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x73, 0x66, 0x2e, 0x61,
+	0x65, 0x6c, 0x66, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61,
+	0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x3c, 0x0a, 0x0e, 0x45, 0x78,
+	0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x86, 0x02, 0x0a, 0x12, 0x43, 0x72, 0x6f,
+	0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12,
+	0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x1e, 0x0a, 0x0b, 0x74,
+	0x6f, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x74, 0x6f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x69, 0x73, 0x73, 0x75, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x64, 0x22, 0xf1, 0x02, 0x0a, 0x11, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d,
+	0x65, 0x6d, 0x6f, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x69, 0x73, 0x73, 0x75, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x2e, 0x0a,
+	0x13, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x36, 0x0a,
+	0x17, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xd4, 0x05, 0x0a, 0x05, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x35, 0x0a,
+	0x17, 0x69, 0x73, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14,
+	0x69, 0x73, 0x45, 0x78, 0x74, 0x72, 0x61, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x49,
+	0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4c, 0x0a, 0x14, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x13, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6d,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x61,
+	0x63, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6e, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65,
+	0x64, 0x54, 0x69, 0x6e, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6d,
+	0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x3e, 0x0a, 0x1c, 0x73, 0x75, 0x70, 0x70, 0x6f,
+	0x73, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x18, 0x73,
+	0x75, 0x70, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4f, 0x66, 0x4e, 0x65,
+	0x78, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x38, 0x0a, 0x19, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+	0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x66, 0x69, 0x6e, 0x61,
+	0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4f, 0x66, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x49, 0x0a, 0x21, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x69, 0x72, 0x72,
+	0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1e, 0x69, 0x6d,
+	0x70, 0x6c, 0x69, 0x65, 0x64, 0x49, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c,
+	0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x2a, 0x6f, 0x0a, 0x0f,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12,
+	0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x59, 0x53,
+	0x54, 0x45, 0x4d, 0x5f, 0x43, 0x4f, 0x4e, 0x53, 0x45, 0x4e, 0x53, 0x55, 0x53, 0x10, 0x01, 0x12,
+	0x13, 0x0a, 0x0f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x43,
+	0x52, 0x4f, 0x53, 0x53, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x53,
+	0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x04, 0x2a, 0xd4, 0x01,
+	0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0c, 0x0a, 0x08, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x15,
+	0x0a, 0x08, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x19, 0x0a, 0x0c, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01,
+	0x12, 0x1b, 0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x54, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x10, 0xf6, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x24, 0x0a,
+	0x17, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x43, 0x41,
+	0x4c, 0x4c, 0x5f, 0x44, 0x45, 0x50, 0x54, 0x48, 0x10, 0xf5, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0x01, 0x12, 0x16, 0x0a, 0x09, 0x50, 0x52, 0x45, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
+	0x10, 0x9d, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x17, 0x0a, 0x0a, 0x50,
+	0x4f, 0x53, 0x54, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0xb9, 0xfe, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0x01, 0x2a, 0x62, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
+	0x73, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x75, 0x72, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x45, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a,
+	0x4e, 0x45, 0x58, 0x54, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
+	0x4e, 0x45, 0x58, 0x54, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x4e,
+	0x4f, 0x54, 0x48, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x49, 0x4e, 0x59,
+	0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x04, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
+	0x66, 0x61, 0x73, 0x74, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x68, 0x6f, 0x73, 0x65, 0x2d, 0x61, 0x65,
+	0x6c, 0x66, 0x2f, 0x70, 0x62, 0x2f, 0x73, 0x66, 0x2f, 0x61, 0x65, 0x6c, 0x66, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x61, 0x65, 0x6c, 0x66, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_sf_aelf_type_v1_type_proto_rawDescOnce sync.Once
+	file_sf_aelf_type_v1_type_proto_rawDescData = file_sf_aelf_type_v1_type_proto_rawDesc
+)
+
+func file_sf_aelf_type_v1_type_proto_rawDescGZIP() []byte {
+	file_sf_aelf_type_v1_type_proto_rawDescOnce.Do(func() {
+		file_sf_aelf_type_v1_type_proto_rawDescData = protoimpl.X.CompressGZIP(file_sf_aelf_type_v1_type_proto_rawDescData)
+	})
 	return file_sf_aelf_type_v1_type_proto_rawDescData
 }
 
-var file_sf_aelf_type_v1_type_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_sf_aelf_type_v1_type_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_sf_aelf_type_v1_type_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_sf_aelf_type_v1_type_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_sf_aelf_type_v1_type_proto_goTypes = []any{
-	(ExecutionStatus)(0),                 // 0: sf.aelf.type.v1.ExecutionStatus
-	(*Block)(nil),                        // 1: sf.aelf.type.v1.Block
-	(*TransactionTrace)(nil),             // 2: sf.aelf.type.v1.TransactionTrace
-	(*Call)(nil),                         // 3: sf.aelf.type.v1.Call
-	(*TransactionExecutingStateSet)(nil), // 4: sf.aelf.type.v1.TransactionExecutingStateSet
-	(*LogEvent)(nil),                     // 5: sf.aelf.type.v1.LogEvent
-	(*BlockHeader)(nil),                  // 6: sf.aelf.type.v1.BlockHeader
-	nil,                                  // 7: sf.aelf.type.v1.TransactionExecutingStateSet.WritesEntry
-	nil,                                  // 8: sf.aelf.type.v1.TransactionExecutingStateSet.ReadsEntry
-	nil,                                  // 9: sf.aelf.type.v1.TransactionExecutingStateSet.DeletesEntry
-	nil,                                  // 10: sf.aelf.type.v1.BlockHeader.ExtraDataEntry
-	(*timestamppb.Timestamp)(nil),        // 11: google.protobuf.Timestamp
+	(TransactionKind)(0),                 // 0: sf.aelf.type.v1.TransactionKind
+	(ExecutionStatus)(0),                 // 1: sf.aelf.type.v1.ExecutionStatus
+	(ConsensusBehaviour)(0),              // 2: sf.aelf.type.v1.ConsensusBehaviour
+	(*Block)(nil),                        // 3: sf.aelf.type.v1.Block
+	(*BlockStats)(nil),                   // 4: sf.aelf.type.v1.BlockStats
+	(*TransactionTrace)(nil),             // 5: sf.aelf.type.v1.TransactionTrace
+	(*Call)(nil),                         // 6: sf.aelf.type.v1.Call
+	(*TransactionExecutingStateSet)(nil), // 7: sf.aelf.type.v1.TransactionExecutingStateSet
+	(*LogEvent)(nil),                     // 8: sf.aelf.type.v1.LogEvent
+	(*BlockHeader)(nil),                  // 9: sf.aelf.type.v1.BlockHeader
+	(*ConsensusInfo)(nil),                // 10: sf.aelf.type.v1.ConsensusInfo
+	(*ConsensusTransition)(nil),          // 11: sf.aelf.type.v1.ConsensusTransition
+	(*CrossChainInfo)(nil),               // 12: sf.aelf.type.v1.CrossChainInfo
+	(*SideChainBlockData)(nil),           // 13: sf.aelf.type.v1.SideChainBlockData
+	(*ParentChainBlockData)(nil),         // 14: sf.aelf.type.v1.ParentChainBlockData
+	(*CrossChainTransfer)(nil),           // 15: sf.aelf.type.v1.CrossChainTransfer
+	(*CrossChainReceive)(nil),            // 16: sf.aelf.type.v1.CrossChainReceive
+	(*Miner)(nil),                        // 17: sf.aelf.type.v1.Miner
+	nil,                                  // 18: sf.aelf.type.v1.BlockStats.FeesBySymbolEntry
+	nil,                                  // 19: sf.aelf.type.v1.TransactionExecutingStateSet.WritesEntry
+	nil,                                  // 20: sf.aelf.type.v1.TransactionExecutingStateSet.ReadsEntry
+	nil,                                  // 21: sf.aelf.type.v1.TransactionExecutingStateSet.DeletesEntry
+	nil,                                  // 22: sf.aelf.type.v1.BlockHeader.ExtraDataEntry
+	nil,                                  // 23: sf.aelf.type.v1.ParentChainBlockData.ExtraDataEntry
+	(*timestamppb.Timestamp)(nil),        // 24: google.protobuf.Timestamp
 }
 var file_sf_aelf_type_v1_type_proto_depIdxs = []int32{
-	6,  // 0: sf.aelf.type.v1.Block.header:type_name -> sf.aelf.type.v1.BlockHeader
-	2,  // 1: sf.aelf.type.v1.Block.transaction_traces:type_name -> sf.aelf.type.v1.TransactionTrace
-	3,  // 2: sf.aelf.type.v1.TransactionTrace.calls:type_name -> sf.aelf.type.v1.Call
-	0,  // 3: sf.aelf.type.v1.Call.execution_status:type_name -> sf.aelf.type.v1.ExecutionStatus
-	4,  // 4: sf.aelf.type.v1.Call.state_set:type_name -> sf.aelf.type.v1.TransactionExecutingStateSet
-	5,  // 5: sf.aelf.type.v1.Call.logs:type_name -> sf.aelf.type.v1.LogEvent
-	7,  // 6: sf.aelf.type.v1.TransactionExecutingStateSet.writes:type_name -> sf.aelf.type.v1.TransactionExecutingStateSet.WritesEntry
-	8,  // 7: sf.aelf.type.v1.TransactionExecutingStateSet.reads:type_name -> sf.aelf.type.v1.TransactionExecutingStateSet.ReadsEntry
-	9,  // 8: sf.aelf.type.v1.TransactionExecutingStateSet.deletes:type_name -> sf.aelf.type.v1.TransactionExecutingStateSet.DeletesEntry
-	10, // 9: sf.aelf.type.v1.BlockHeader.extra_data:type_name -> sf.aelf.type.v1.BlockHeader.ExtraDataEntry
-	11, // 10: sf.aelf.type.v1.BlockHeader.time:type_name -> google.protobuf.Timestamp
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	9,  // 0: sf.aelf.type.v1.Block.header:type_name -> sf.aelf.type.v1.BlockHeader
+	5,  // 1: sf.aelf.type.v1.Block.transaction_traces:type_name -> sf.aelf.type.v1.TransactionTrace
+	11, // 2: sf.aelf.type.v1.Block.consensus_transition:type_name -> sf.aelf.type.v1.ConsensusTransition
+	12, // 3: sf.aelf.type.v1.Block.cross_chain:type_name -> sf.aelf.type.v1.CrossChainInfo
+	4,  // 4: sf.aelf.type.v1.Block.stats:type_name -> sf.aelf.type.v1.BlockStats
+	18, // 5: sf.aelf.type.v1.BlockStats.fees_by_symbol:type_name -> sf.aelf.type.v1.BlockStats.FeesBySymbolEntry
+	6,  // 6: sf.aelf.type.v1.TransactionTrace.calls:type_name -> sf.aelf.type.v1.Call
+	0,  // 7: sf.aelf.type.v1.TransactionTrace.kind:type_name -> sf.aelf.type.v1.TransactionKind
+	1,  // 8: sf.aelf.type.v1.Call.execution_status:type_name -> sf.aelf.type.v1.ExecutionStatus
+	7,  // 9: sf.aelf.type.v1.Call.state_set:type_name -> sf.aelf.type.v1.TransactionExecutingStateSet
+	8,  // 10: sf.aelf.type.v1.Call.logs:type_name -> sf.aelf.type.v1.LogEvent
+	19, // 11: sf.aelf.type.v1.TransactionExecutingStateSet.writes:type_name -> sf.aelf.type.v1.TransactionExecutingStateSet.WritesEntry
+	20, // 12: sf.aelf.type.v1.TransactionExecutingStateSet.reads:type_name -> sf.aelf.type.v1.TransactionExecutingStateSet.ReadsEntry
+	21, // 13: sf.aelf.type.v1.TransactionExecutingStateSet.deletes:type_name -> sf.aelf.type.v1.TransactionExecutingStateSet.DeletesEntry
+	22, // 14: sf.aelf.type.v1.BlockHeader.extra_data:type_name -> sf.aelf.type.v1.BlockHeader.ExtraDataEntry
+	24, // 15: sf.aelf.type.v1.BlockHeader.time:type_name -> google.protobuf.Timestamp
+	10, // 16: sf.aelf.type.v1.BlockHeader.consensus:type_name -> sf.aelf.type.v1.ConsensusInfo
+	2,  // 17: sf.aelf.type.v1.ConsensusInfo.behaviour:type_name -> sf.aelf.type.v1.ConsensusBehaviour
+	17, // 18: sf.aelf.type.v1.ConsensusInfo.miners:type_name -> sf.aelf.type.v1.Miner
+	17, // 19: sf.aelf.type.v1.ConsensusTransition.miners:type_name -> sf.aelf.type.v1.Miner
+	8,  // 20: sf.aelf.type.v1.ConsensusTransition.logs:type_name -> sf.aelf.type.v1.LogEvent
+	13, // 21: sf.aelf.type.v1.CrossChainInfo.side_chain_block_data:type_name -> sf.aelf.type.v1.SideChainBlockData
+	14, // 22: sf.aelf.type.v1.CrossChainInfo.parent_chain_block_data:type_name -> sf.aelf.type.v1.ParentChainBlockData
+	15, // 23: sf.aelf.type.v1.CrossChainInfo.transfers:type_name -> sf.aelf.type.v1.CrossChainTransfer
+	16, // 24: sf.aelf.type.v1.CrossChainInfo.receives:type_name -> sf.aelf.type.v1.CrossChainReceive
+	23, // 25: sf.aelf.type.v1.ParentChainBlockData.extra_data:type_name -> sf.aelf.type.v1.ParentChainBlockData.ExtraDataEntry
+	24, // 26: sf.aelf.type.v1.Miner.expected_mining_time:type_name -> google.protobuf.Timestamp
+	24, // 27: sf.aelf.type.v1.Miner.actual_mining_times:type_name -> google.protobuf.Timestamp
+	28, // [28:28] is the sub-list for method output_type
+	28, // [28:28] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_sf_aelf_type_v1_type_proto_init() }
@@ -913,7 +2494,7 @@ func file_sf_aelf_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_aelf_type_v1_type_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*TransactionTrace); i {
+			switch v := v.(*BlockStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -925,7 +2506,7 @@ func file_sf_aelf_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_aelf_type_v1_type_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*Call); i {
+			switch v := v.(*TransactionTrace); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -937,7 +2518,7 @@ func file_sf_aelf_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_aelf_type_v1_type_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*TransactionExecutingStateSet); i {
+			switch v := v.(*Call); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -949,7 +2530,7 @@ func file_sf_aelf_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_aelf_type_v1_type_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*LogEvent); i {
+			switch v := v.(*TransactionExecutingStateSet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -961,6 +2542,18 @@ func file_sf_aelf_type_v1_type_proto_init() {
 			}
 		}
 		file_sf_aelf_type_v1_type_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*LogEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_aelf_type_v1_type_proto_msgTypes[6].Exporter = func(v any, i int) any {
 			switch v := v.(*BlockHeader); i {
 			case 0:
 				return &v.state
@@ -972,14 +2565,110 @@ func file_sf_aelf_type_v1_type_proto_init() {
 				return nil
 			}
 		}
+		file_sf_aelf_type_v1_type_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*ConsensusInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_aelf_type_v1_type_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*ConsensusTransition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_aelf_type_v1_type_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*CrossChainInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_aelf_type_v1_type_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*SideChainBlockData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_aelf_type_v1_type_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*ParentChainBlockData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_aelf_type_v1_type_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*CrossChainTransfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_aelf_type_v1_type_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*CrossChainReceive); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sf_aelf_type_v1_type_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*Miner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sf_aelf_type_v1_type_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      3,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,15 @@
+// Generates the Rust types of the protobuf definitions found in `../proto`, the same definitions
+// packaged by `substreams.yaml`.
+fn main() -> std::io::Result<()> {
+    std::env::set_var("PROTOC", protoc_bin_vendored::protoc_bin_path().expect("vendored protoc"));
+
+    prost_build::compile_protos(
+        &[
+            "../proto/aelf/token_contract.proto",
+            "../proto/aelf/genesis_contract.proto",
+            "../proto/sf/aelf/type/v1/type.proto",
+            "../proto/sf/aelf/substreams/v1/modules.proto",
+        ],
+        &["../proto"],
+    )
+}
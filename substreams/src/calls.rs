@@ -0,0 +1,184 @@
+use crate::pb::sf::aelf::r#type::v1::{Block, Call, ExecutionStatus};
+use crate::pb::sf::aelf::substreams::v1::{FilteredCall, FilteredCalls};
+use substreams::errors::Error;
+
+/// The call criteria of `map_filtered_calls`, matching the `sf.aelf.transform.v1.CallFilter` Firehose
+/// transform. A call matches when it matches all the set criteria, a criterion left empty matches any call.
+#[derive(Debug, Default, PartialEq)]
+pub struct CallFilter {
+    pub addresses: Vec<String>,
+    pub methods: Vec<String>,
+    pub events: Vec<String>,
+    pub status: CallStatus,
+}
+
+#[derive(Debug, Default, PartialEq)]
+pub enum CallStatus {
+    #[default]
+    Any,
+    Succeeded,
+    Failed,
+}
+
+impl CallFilter {
+    /// Parses the module params, a query string like `addresses=<a>,<b>&methods=Transfer&status=succeeded`
+    /// accepting the `addresses`, `methods`, `events` and `status` (`any`, `succeeded` or `failed`) keys.
+    pub fn parse(params: &str) -> Result<CallFilter, Error> {
+        let mut filter = CallFilter::default();
+
+        for pair in params.split('&').map(str::trim).filter(|pair| !pair.is_empty()) {
+            let (key, value) = pair
+                .split_once('=')
+                .ok_or_else(|| Error::msg(format!("invalid param {:?}, expecting <key>=<value>", pair)))?;
+
+            let values = value
+                .split(',')
+                .map(str::trim)
+                .filter(|value| !value.is_empty())
+                .map(String::from);
+
+            match key.trim() {
+                "addresses" => filter.addresses.extend(values),
+                "methods" => filter.methods.extend(values),
+                "events" => filter.events.extend(values),
+                "status" => {
+                    filter.status = match value.trim() {
+                        "" | "any" => CallStatus::Any,
+                        "succeeded" => CallStatus::Succeeded,
+                        "failed" => CallStatus::Failed,
+                        status => {
+                            return Err(Error::msg(format!(
+                                "invalid status {:?}, expecting 'any', 'succeeded' or 'failed'",
+                                status
+                            )))
+                        }
+                    }
+                }
+                key => return Err(Error::msg(format!("unknown param {:?}", key))),
+            }
+        }
+
+        Ok(filter)
+    }
+
+    pub fn matches(&self, call: &Call) -> bool {
+        if !self.addresses.is_empty() && !self.addresses.contains(&call.to) {
+            return false;
+        }
+        if !self.methods.is_empty() && !self.methods.contains(&call.method_name) {
+            return false;
+        }
+        if !self.events.is_empty() && !call.logs.iter().any(|log| self.events.contains(&log.name)) {
+            return false;
+        }
+
+        let succeeded = call.execution_status == ExecutionStatus::Executed as i32 && !call.is_reverted;
+        match self.status {
+            CallStatus::Any => true,
+            CallStatus::Succeeded => succeeded,
+            CallStatus::Failed => !succeeded,
+        }
+    }
+}
+
+pub fn filtered_calls(filter: &CallFilter, block: &Block) -> FilteredCalls {
+    let calls = block
+        .transaction_traces
+        .iter()
+        .flat_map(|trace| {
+            trace
+                .calls
+                .iter()
+                .filter(move |call| filter.matches(call))
+                .map(move |call| FilteredCall {
+                    transaction_id: trace.transaction_id.clone(),
+                    transaction_kind: trace.kind,
+                    call: Some(call.clone()),
+                })
+        })
+        .collect();
+
+    FilteredCalls { calls }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use crate::pb::sf::aelf::r#type::v1::{LogEvent, TransactionTrace};
+
+    #[test]
+    fn test_parse() {
+        assert_eq!(CallFilter::parse("").unwrap(), CallFilter::default());
+        assert_eq!(
+            CallFilter::parse("addresses=token, dex&methods=Transfer&events=Transferred&status=failed").unwrap(),
+            CallFilter {
+                addresses: vec!["token".to_string(), "dex".to_string()],
+                methods: vec!["Transfer".to_string()],
+                events: vec!["Transferred".to_string()],
+                status: CallStatus::Failed,
+            }
+        );
+
+        assert!(CallFilter::parse("addresses").is_err());
+        assert!(CallFilter::parse("contract=token").is_err());
+        assert!(CallFilter::parse("status=reverted").is_err());
+    }
+
+    #[test]
+    fn test_filtered_calls() {
+        let block = Block {
+            transaction_traces: vec![
+                TransactionTrace {
+                    transaction_id: "transfer".to_string(),
+                    calls: vec![Call {
+                        to: "token".to_string(),
+                        method_name: "Transfer".to_string(),
+                        execution_status: ExecutionStatus::Executed as i32,
+                        logs: vec![LogEvent {
+                            name: "Transferred".to_string(),
+                            ..Default::default()
+                        }],
+                        ..Default::default()
+                    }],
+                    ..Default::default()
+                },
+                TransactionTrace {
+                    transaction_id: "swap".to_string(),
+                    calls: vec![
+                        Call {
+                            call_path: ":0".to_string(),
+                            to: "dex".to_string(),
+                            method_name: "Swap".to_string(),
+                            execution_status: ExecutionStatus::Executed as i32,
+                            ..Default::default()
+                        },
+                        Call {
+                            call_path: ":0:0".to_string(),
+                            to: "token".to_string(),
+                            method_name: "TransferFrom".to_string(),
+                            execution_status: ExecutionStatus::Executed as i32,
+                            is_reverted: true,
+                            ..Default::default()
+                        },
+                    ],
+                    ..Default::default()
+                },
+            ],
+            ..Default::default()
+        };
+
+        let ids = |params: &str| -> Vec<String> {
+            filtered_calls(&CallFilter::parse(params).unwrap(), &block)
+                .calls
+                .into_iter()
+                .map(|call| format!("{}{}", call.transaction_id, call.call.unwrap().call_path))
+                .collect()
+        };
+
+        assert_eq!(ids(""), vec!["transfer", "swap:0", "swap:0:0"]);
+        assert_eq!(ids("addresses=token"), vec!["transfer", "swap:0:0"]);
+        assert_eq!(ids("addresses=token&status=succeeded"), vec!["transfer"]);
+        assert_eq!(ids("events=Transferred"), vec!["transfer"]);
+        assert_eq!(ids("methods=Swap,TransferFrom&status=failed"), vec!["swap:0:0"]);
+    }
+}
@@ -0,0 +1,29 @@
+use crate::encoding::{address_to_base58, decode_event, hash_to_hex};
+use crate::pb::aelf::ContractDeployed;
+use crate::pb::sf::aelf::substreams::v1::{ContractDeployment, ContractDeployments, Events};
+use substreams::errors::Error;
+
+/// Returns the contracts deployed through the `ContractDeployed` event of the Genesis contract.
+pub fn contract_deployments(events: &Events) -> Result<ContractDeployments, Error> {
+    let mut deployments = Vec::new();
+    for event in &events.events {
+        let Some(log) = &event.log else { continue };
+        if log.name != "ContractDeployed" {
+            continue;
+        }
+
+        let deployed: ContractDeployed = decode_event(log)?;
+        deployments.push(ContractDeployment {
+            transaction_id: event.transaction_id.clone(),
+            address: address_to_base58(&deployed.address),
+            author: address_to_base58(&deployed.author),
+            deployer: address_to_base58(&deployed.deployer),
+            code_hash: hash_to_hex(&deployed.code_hash),
+            name: hash_to_hex(&deployed.name),
+            version: deployed.version,
+            contract_version: deployed.contract_version,
+        });
+    }
+
+    Ok(ContractDeployments { deployments })
+}
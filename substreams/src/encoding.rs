@@ -0,0 +1,86 @@
+use crate::pb::aelf::{Address, Hash};
+use crate::pb::sf::aelf::r#type::v1::LogEvent;
+use prost::Message;
+
+/// Renders address in base58 with checksum like `Address.ToBase58` in Go, empty when unset.
+pub fn address_to_base58(address: &Option<Address>) -> String {
+    match address {
+        Some(address) => bs58::encode(&address.value).with_check().into_string(),
+        None => String::new(),
+    }
+}
+
+/// Renders hash in hex like `Hash.ToHex` in Go, empty when unset.
+pub fn hash_to_hex(hash: &Option<Hash>) -> String {
+    match hash {
+        Some(hash) => hex::encode(&hash.value),
+        None => String::new(),
+    }
+}
+
+/// Decodes an AElf event, each indexed field is encoded as a separate message followed by the non
+/// indexed fields, like `LogEvent.Decode` in Go.
+pub fn decode_event<T: Message + Default>(log: &LogEvent) -> Result<T, prost::DecodeError> {
+    let mut message = T::default();
+    for indexed in &log.indexed {
+        message.merge(indexed.as_slice())?;
+    }
+    message.merge(log.non_indexed.as_slice())?;
+    Ok(message)
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use crate::pb::aelf::Transferred;
+
+    #[test]
+    fn test_address_to_base58() {
+        // The MultiToken contract address of the AElf main chain
+        let value = bs58::decode("JRmBduh4nXWi1aXgdUsj5gJrzeZb2LxmrAbf7W99faZSvoAaE")
+            .with_check(None)
+            .into_vec()
+            .unwrap();
+        assert_eq!(value.len(), 32);
+
+        assert_eq!(
+            address_to_base58(&Some(Address { value })),
+            "JRmBduh4nXWi1aXgdUsj5gJrzeZb2LxmrAbf7W99faZSvoAaE"
+        );
+        assert_eq!(address_to_base58(&None), "");
+    }
+
+    #[test]
+    fn test_decode_event() {
+        let from = Address { value: vec![1; 32] };
+        let log = LogEvent {
+            name: "Transferred".to_string(),
+            indexed: vec![
+                Transferred {
+                    from: Some(from.clone()),
+                    ..Default::default()
+                }
+                .encode_to_vec(),
+                Transferred {
+                    symbol: "ELF".to_string(),
+                    ..Default::default()
+                }
+                .encode_to_vec(),
+            ],
+            non_indexed: Transferred {
+                amount: 100,
+                memo: "hi".to_string(),
+                ..Default::default()
+            }
+            .encode_to_vec(),
+            ..Default::default()
+        };
+
+        let transferred: Transferred = decode_event(&log).unwrap();
+        assert_eq!(transferred.from, Some(from));
+        assert_eq!(transferred.to, None);
+        assert_eq!(transferred.symbol, "ELF");
+        assert_eq!(transferred.amount, 100);
+        assert_eq!(transferred.memo, "hi");
+    }
+}
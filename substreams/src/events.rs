@@ -0,0 +1,22 @@
+use crate::pb::sf::aelf::r#type::v1::Block;
+use crate::pb::sf::aelf::substreams::v1::{Event, Events};
+
+/// Returns the log events of block in execution order, the logs of reverted calls are skipped as their
+/// effects were rolled back.
+pub fn events(block: &Block) -> Events {
+    let mut events = Vec::new();
+    for trace in &block.transaction_traces {
+        for call in trace.calls.iter().filter(|call| !call.is_reverted) {
+            for log in &call.logs {
+                events.push(Event {
+                    transaction_id: trace.transaction_id.clone(),
+                    call_path: call.call_path.clone(),
+                    log: Some(log.clone()),
+                    ordinal: events.len() as u64,
+                });
+            }
+        }
+    }
+
+    Events { events }
+}
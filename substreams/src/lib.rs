@@ -0,0 +1,294 @@
+mod calls;
+mod deployments;
+mod encoding;
+mod events;
+mod pb;
+mod tokens;
+
+use pb::sf::aelf::r#type::v1::Block;
+use pb::sf::aelf::substreams::v1::{ContractDeployments, Events, FilteredCalls, TokenTransfers};
+use substreams::errors::Error;
+use substreams::store::{StoreAdd, StoreAddInt64};
+
+#[substreams::handlers::map]
+fn map_filtered_calls(params: String, block: Block) -> Result<FilteredCalls, Error> {
+    let filter = calls::CallFilter::parse(&params)?;
+    Ok(calls::filtered_calls(&filter, &block))
+}
+
+#[substreams::handlers::map]
+fn map_events(block: Block) -> Result<Events, Error> {
+    Ok(events::events(&block))
+}
+
+#[substreams::handlers::map]
+fn map_token_transfers(params: String, events: Events) -> Result<TokenTransfers, Error> {
+    tokens::token_transfers(params.trim(), &events)
+}
+
+#[substreams::handlers::store]
+fn store_token_balances(transfers: TokenTransfers, store: StoreAddInt64) {
+    for transfer in &transfers.transfers {
+        for (key, delta) in tokens::balance_deltas(transfer) {
+            store.add(transfer.ordinal, key, delta);
+        }
+    }
+}
+
+#[substreams::handlers::map]
+fn map_contract_deployments(events: Events) -> Result<ContractDeployments, Error> {
+    deployments::contract_deployments(&events)
+}
+
+#[cfg(test)]
+mod tests {
+    use crate::calls::{filtered_calls, CallFilter};
+    use crate::deployments::contract_deployments;
+    use crate::events::events;
+    use crate::pb::aelf::{Address, ContractDeployed, Hash, TransactionFeeCharged, Transferred};
+    use crate::pb::sf::aelf::r#type::v1::{Block, Call, LogEvent, TransactionTrace};
+    use crate::pb::sf::aelf::substreams::v1::token_transfer::Type;
+    use crate::tokens::{balance_deltas, balance_key, token_transfers};
+    use prost::Message;
+
+    const TOKEN_CONTRACT: &str = "JRmBduh4nXWi1aXgdUsj5gJrzeZb2LxmrAbf7W99faZSvoAaE";
+
+    // Block #97 of the AElf main chain (`tiny-block` of the Go corpus), kept in sync with the converter by
+    // `go test ./block -run SubstreamsFixture -update`
+    fn fixture_block() -> Block {
+        Block::decode(&include_bytes!("../fixtures/block_97.binpb")[..]).unwrap()
+    }
+
+    fn address(byte: u8) -> Address {
+        Address { value: vec![byte; 32] }
+    }
+
+    fn base58(byte: u8) -> String {
+        crate::encoding::address_to_base58(&Some(address(byte)))
+    }
+
+    fn log<T: Message>(address: &str, name: &str, indexed: Vec<T>, non_indexed: T) -> LogEvent {
+        LogEvent {
+            address: address.to_string(),
+            name: name.to_string(),
+            indexed: indexed.iter().map(|message| message.encode_to_vec()).collect(),
+            non_indexed: non_indexed.encode_to_vec(),
+        }
+    }
+
+    fn token_block() -> Block {
+        let transfer = log(
+            TOKEN_CONTRACT,
+            "Transferred",
+            vec![
+                Transferred {
+                    from: Some(address(1)),
+                    ..Default::default()
+                },
+                Transferred {
+                    to: Some(address(2)),
+                    ..Default::default()
+                },
+                Transferred {
+                    symbol: "ELF".to_string(),
+                    ..Default::default()
+                },
+            ],
+            Transferred {
+                amount: 100,
+                memo: "rent".to_string(),
+                ..Default::default()
+            },
+        );
+        let fee = log(
+            TOKEN_CONTRACT,
+            "TransactionFeeCharged",
+            vec![],
+            TransactionFeeCharged {
+                symbol: "ELF".to_string(),
+                amount: 3,
+                charging_address: Some(address(1)),
+            },
+        );
+        let spoofed = log(
+            "other",
+            "Transferred",
+            vec![],
+            Transferred {
+                to: Some(address(3)),
+                symbol: "ELF".to_string(),
+                amount: 1_000,
+                ..Default::default()
+            },
+        );
+        let deployed = log(
+            "genesis",
+            "ContractDeployed",
+            vec![
+                ContractDeployed {
+                    author: Some(address(1)),
+                    ..Default::default()
+                },
+                ContractDeployed {
+                    code_hash: Some(Hash { value: vec![0xab; 32] }),
+                    ..Default::default()
+                },
+            ],
+            ContractDeployed {
+                address: Some(address(4)),
+                version: 1,
+                contract_version: "1.0.0.0".to_string(),
+                deployer: Some(address(1)),
+                ..Default::default()
+            },
+        );
+
+        Block {
+            transaction_traces: vec![
+                TransactionTrace {
+                    transaction_id: "transfer".to_string(),
+                    calls: vec![
+                        Call {
+                            call_path: ":0:pre:0".to_string(),
+                            logs: vec![fee],
+                            ..Default::default()
+                        },
+                        Call {
+                            call_path: ":0".to_string(),
+                            logs: vec![transfer.clone(), spoofed],
+                            ..Default::default()
+                        },
+                    ],
+                    ..Default::default()
+                },
+                TransactionTrace {
+                    transaction_id: "reverted".to_string(),
+                    calls: vec![Call {
+                        call_path: ":0".to_string(),
+                        is_reverted: true,
+                        logs: vec![transfer],
+                        ..Default::default()
+                    }],
+                    ..Default::default()
+                },
+                TransactionTrace {
+                    transaction_id: "deploy".to_string(),
+                    calls: vec![Call {
+                        call_path: ":0".to_string(),
+                        logs: vec![deployed],
+                        ..Default::default()
+                    }],
+                    ..Default::default()
+                },
+            ],
+            ..Default::default()
+        }
+    }
+
+    #[test]
+    fn test_fixture_block() {
+        let block = fixture_block();
+        assert_eq!(block.height, 97);
+
+        let calls = filtered_calls(
+            &CallFilter::parse(&format!("addresses={}&methods=DonateResourceToken", TOKEN_CONTRACT)).unwrap(),
+            &block,
+        );
+        assert_eq!(calls.calls.len(), 1);
+        assert_eq!(
+            calls.calls[0].transaction_id,
+            "b02fc3274af7f35488c1b14b85da32228aadca455b26dc4371a3b1029e3ca55d"
+        );
+
+        let calls = filtered_calls(
+            &CallFilter::parse("methods=ChargeTransactionFees&status=succeeded").unwrap(),
+            &block,
+        );
+        assert_eq!(calls.calls.len(), 2);
+
+        let events = events(&block);
+        assert_eq!(events.events.len(), 1);
+        assert_eq!(events.events[0].log.as_ref().unwrap().name, "MiningInformationUpdated");
+        assert_eq!(events.events[0].call_path, ":0");
+
+        assert!(token_transfers(TOKEN_CONTRACT, &events).unwrap().transfers.is_empty());
+        assert!(contract_deployments(&events).unwrap().deployments.is_empty());
+    }
+
+    #[test]
+    fn test_events() {
+        let events = events(&token_block());
+
+        let names: Vec<(String, u64)> = events
+            .events
+            .iter()
+            .map(|event| {
+                (
+                    format!(
+                        "{}{} {}",
+                        event.transaction_id,
+                        event.call_path,
+                        event.log.as_ref().unwrap().name
+                    ),
+                    event.ordinal,
+                )
+            })
+            .collect();
+        assert_eq!(
+            names,
+            vec![
+                ("transfer:0:pre:0 TransactionFeeCharged".to_string(), 0),
+                ("transfer:0 Transferred".to_string(), 1),
+                ("transfer:0 Transferred".to_string(), 2),
+                ("deploy:0 ContractDeployed".to_string(), 3),
+            ]
+        );
+    }
+
+    #[test]
+    fn test_token_transfers() {
+        let events = events(&token_block());
+
+        let transfers = token_transfers(TOKEN_CONTRACT, &events).unwrap().transfers;
+        assert_eq!(transfers.len(), 2);
+
+        let fee = &transfers[0];
+        assert_eq!(fee.r#type, Type::Fee as i32);
+        assert_eq!(
+            (fee.from.as_str(), fee.to.as_str(), fee.symbol.as_str(), fee.amount),
+            (base58(1).as_str(), "", "ELF", 3)
+        );
+
+        let transfer = &transfers[1];
+        assert_eq!(transfer.r#type, Type::Transfer as i32);
+        assert_eq!(transfer.transaction_id, "transfer");
+        assert_eq!(transfer.memo, "rent");
+        assert_eq!(transfer.ordinal, 1);
+        assert_eq!(
+            balance_deltas(transfer),
+            vec![
+                (balance_key(&base58(1), "ELF"), -100),
+                (balance_key(&base58(2), "ELF"), 100)
+            ]
+        );
+
+        // Without a token contract, the same named event of another contract is decoded too
+        assert_eq!(token_transfers("", &events).unwrap().transfers.len(), 3);
+    }
+
+    #[test]
+    fn test_contract_deployments() {
+        let deployments = contract_deployments(&events(&token_block())).unwrap().deployments;
+        assert_eq!(deployments.len(), 1);
+
+        let deployment = &deployments[0];
+        assert_eq!(deployment.transaction_id, "deploy");
+        assert_eq!(deployment.address, base58(4));
+        assert_eq!(deployment.author, base58(1));
+        assert_eq!(deployment.deployer, base58(1));
+        assert_eq!(deployment.code_hash, "ab".repeat(32));
+        assert_eq!(deployment.name, "");
+        assert_eq!(deployment.version, 1);
+        assert_eq!(deployment.contract_version, "1.0.0.0");
+    }
+}
@@ -0,0 +1,19 @@
+pub mod aelf {
+    include!(concat!(env!("OUT_DIR"), "/aelf.rs"));
+}
+
+pub mod sf {
+    pub mod aelf {
+        pub mod r#type {
+            pub mod v1 {
+                include!(concat!(env!("OUT_DIR"), "/sf.aelf.type.v1.rs"));
+            }
+        }
+
+        pub mod substreams {
+            pub mod v1 {
+                include!(concat!(env!("OUT_DIR"), "/sf.aelf.substreams.v1.rs"));
+            }
+        }
+    }
+}
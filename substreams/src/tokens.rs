@@ -0,0 +1,135 @@
+use crate::encoding::{address_to_base58, decode_event};
+use crate::pb::aelf::{Burned, CrossChainReceived, CrossChainTransferred, Issued, TransactionFeeCharged, Transferred};
+use crate::pb::sf::aelf::substreams::v1::token_transfer::Type;
+use crate::pb::sf::aelf::substreams::v1::{Event, Events, TokenTransfer, TokenTransfers};
+use substreams::errors::Error;
+
+/// Returns the MultiToken balance changes found in events. Event names are only unique within a contract,
+/// set token_contract to the MultiToken contract address of the chain to ignore same named events of
+/// other contracts.
+pub fn token_transfers(token_contract: &str, events: &Events) -> Result<TokenTransfers, Error> {
+    let mut transfers = Vec::new();
+    for event in &events.events {
+        let Some(log) = &event.log else { continue };
+        if !token_contract.is_empty() && log.address != token_contract {
+            continue;
+        }
+
+        let transfer = match log.name.as_str() {
+            "Transferred" => {
+                let e: Transferred = decode_event(log)?;
+                new_transfer(
+                    event,
+                    Type::Transfer,
+                    address_to_base58(&e.from),
+                    address_to_base58(&e.to),
+                    e.symbol,
+                    e.amount,
+                    e.memo,
+                )
+            }
+            "Issued" => {
+                let e: Issued = decode_event(log)?;
+                new_transfer(
+                    event,
+                    Type::Issue,
+                    String::new(),
+                    address_to_base58(&e.to),
+                    e.symbol,
+                    e.amount,
+                    e.memo,
+                )
+            }
+            "Burned" => {
+                let e: Burned = decode_event(log)?;
+                new_transfer(
+                    event,
+                    Type::Burn,
+                    address_to_base58(&e.burner),
+                    String::new(),
+                    e.symbol,
+                    e.amount,
+                    String::new(),
+                )
+            }
+            "TransactionFeeCharged" => {
+                let e: TransactionFeeCharged = decode_event(log)?;
+                new_transfer(
+                    event,
+                    Type::Fee,
+                    address_to_base58(&e.charging_address),
+                    String::new(),
+                    e.symbol,
+                    e.amount,
+                    String::new(),
+                )
+            }
+            "CrossChainTransferred" => {
+                let e: CrossChainTransferred = decode_event(log)?;
+                new_transfer(
+                    event,
+                    Type::CrossChainTransfer,
+                    address_to_base58(&e.from),
+                    String::new(),
+                    e.symbol,
+                    e.amount,
+                    e.memo,
+                )
+            }
+            "CrossChainReceived" => {
+                let e: CrossChainReceived = decode_event(log)?;
+                new_transfer(
+                    event,
+                    Type::CrossChainReceive,
+                    String::new(),
+                    address_to_base58(&e.to),
+                    e.symbol,
+                    e.amount,
+                    e.memo,
+                )
+            }
+            _ => continue,
+        };
+        transfers.push(transfer);
+    }
+
+    Ok(TokenTransfers { transfers })
+}
+
+fn new_transfer(
+    event: &Event,
+    r#type: Type,
+    from: String,
+    to: String,
+    symbol: String,
+    amount: i64,
+    memo: String,
+) -> TokenTransfer {
+    TokenTransfer {
+        transaction_id: event.transaction_id.clone(),
+        call_path: event.call_path.clone(),
+        r#type: r#type as i32,
+        from,
+        to,
+        symbol,
+        amount,
+        memo,
+        ordinal: event.ordinal,
+    }
+}
+
+/// Returns the `<address>:<symbol>` balance deltas of transfer, debiting `from` and crediting `to`.
+pub fn balance_deltas(transfer: &TokenTransfer) -> Vec<(String, i64)> {
+    let mut deltas = Vec::new();
+    if !transfer.from.is_empty() {
+        deltas.push((balance_key(&transfer.from, &transfer.symbol), -transfer.amount));
+    }
+    if !transfer.to.is_empty() {
+        deltas.push((balance_key(&transfer.to, &transfer.symbol), transfer.amount));
+    }
+    deltas
+}
+
+pub fn balance_key(address: &str, symbol: &str) -> String {
+    format!("{}:{}", address, symbol)
+}
@@ -0,0 +1,205 @@
+package transform
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/bstream"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+	pbtransform "github.com/streamingfast/firehose-aelf/pb/sf/aelf/transform/v1"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+var CallFilterMessageName = proto.MessageName(&pbtransform.CallFilter{})
+
+func CallFilterFactory(indexStore dstore.Store, possibleIndexSizes []uint64) (*transform.Factory, error) {
+	return &transform.Factory{
+		Obj: &pbtransform.CallFilter{},
+		NewFunc: func(message *anypb.Any) (transform.Transform, error) {
+			mname := message.MessageName()
+			if mname != CallFilterMessageName {
+				return nil, fmt.Errorf("expected type url %q, received %q", CallFilterMessageName, message.TypeUrl)
+			}
+
+			filter := &pbtransform.CallFilter{}
+			if err := proto.Unmarshal(message.Value, filter); err != nil {
+				return nil, fmt.Errorf("unexpected unmarshal error: %w", err)
+			}
+
+			return newCallFilter(filter, indexStore, possibleIndexSizes), nil
+		},
+	}, nil
+}
+
+type CallFilter struct {
+	Addresses map[string]bool
+	Methods   map[string]bool
+	Events    map[string]bool
+	Status    pbtransform.CallFilter_Status
+
+	indexStore         dstore.Store
+	possibleIndexSizes []uint64
+}
+
+func newCallFilter(filter *pbtransform.CallFilter, indexStore dstore.Store, possibleIndexSizes []uint64) *CallFilter {
+	return &CallFilter{
+		Addresses:          toSet(filter.Addresses),
+		Methods:            toSet(filter.Methods),
+		Events:             toSet(filter.Events),
+		Status:             filter.Status,
+		indexStore:         indexStore,
+		possibleIndexSizes: possibleIndexSizes,
+	}
+}
+
+func (f *CallFilter) String() string {
+	return fmt.Sprintf("CallFilter{addresses: [%s], methods: [%s], events: [%s], status: %s}",
+		joinSet(f.Addresses), joinSet(f.Methods), joinSet(f.Events), f.Status)
+}
+
+func (f *CallFilter) Transform(readOnlyBlk *pbbstream.Block, in transform.Input) (transform.Output, error) {
+	block, err := inputBlock(readOnlyBlk, in)
+	if err != nil {
+		return nil, err
+	}
+
+	var traces []*pbaelf.TransactionTrace
+	for _, trace := range block.TransactionTraces {
+		for _, call := range trace.Calls {
+			if f.matches(call) {
+				traces = append(traces, trace)
+				break
+			}
+		}
+	}
+	block.TransactionTraces = traces
+	return block, nil
+}
+
+// matches returns whether call matches the criteria of f, empty call addresses and method names, which are
+// not indexed, never matching an empty filter value.
+func (f *CallFilter) matches(call *pbaelf.Call) bool {
+	if len(f.Addresses) > 0 && (call.To == "" || !f.Addresses[call.To]) {
+		return false
+	}
+	if len(f.Methods) > 0 && (call.MethodName == "" || !f.Methods[call.MethodName]) {
+		return false
+	}
+	if len(f.Events) > 0 && !hasEvent(call, f.Events) {
+		return false
+	}
+
+	succeeded := call.ExecutionStatus == pbaelf.ExecutionStatus_EXECUTED && !call.IsReverted
+	switch f.Status {
+	case pbtransform.CallFilter_SUCCEEDED:
+		return succeeded
+	case pbtransform.CallFilter_FAILED:
+		return !succeeded
+	}
+	return true
+}
+
+func hasEvent(call *pbaelf.Call, events map[string]bool) bool {
+	for _, event := range call.Logs {
+		if event.Name != "" && events[event.Name] {
+			return true
+		}
+	}
+	return false
+}
+
+// GetIndexProvider returns the block index provider skipping the blocks without a possible match, the
+// status criterion is not indexed so a filter on status only scans every block.
+func (f *CallFilter) GetIndexProvider() bstream.BlockIndexProvider {
+	if f.indexStore == nil {
+		return nil
+	}
+	if len(f.Addresses) == 0 && len(f.Methods) == 0 && len(f.Events) == 0 {
+		return nil
+	}
+
+	return transform.NewGenericBlockIndexProvider(
+		f.indexStore,
+		IndexerShortName,
+		f.possibleIndexSizes,
+		func(getter transform.BitmapGetter) []uint64 {
+			return f.matchingBlocks(getter)
+		},
+	)
+}
+
+// matchingBlocks intersects, for each criterion set, the union of the blocks containing one of its keys.
+func (f *CallFilter) matchingBlocks(getter transform.BitmapGetter) []uint64 {
+	var out *roaring64.Bitmap
+	for _, criterion := range []struct {
+		values map[string]bool
+		key    func(string) string
+	}{
+		{f.Addresses, ContractKey},
+		{f.Methods, MethodKey},
+		{f.Events, EventKey},
+	} {
+		if len(criterion.values) == 0 {
+			continue
+		}
+
+		union := roaring64.NewBitmap()
+		for value := range criterion.values {
+			if bitmap := getter.Get(criterion.key(value)); bitmap != nil {
+				union.Or(bitmap)
+			}
+		}
+
+		if out == nil {
+			out = union
+		} else {
+			out.And(union)
+		}
+	}
+
+	if out == nil {
+		return nil
+	}
+	return out.ToArray()
+}
+
+// inputBlock returns a copy of the block, safe to modify, from the output of the previous transform or
+// from the read-only bstream block when it's the first one.
+func inputBlock(readOnlyBlk *pbbstream.Block, in transform.Input) (*pbaelf.Block, error) {
+	if in.Type() != transform.NilObjectType {
+		block, ok := in.Obj().(*pbaelf.Block)
+		if !ok {
+			return nil, fmt.Errorf("unexpected transform input of type %s", in.Type())
+		}
+		return block, nil
+	}
+
+	block := &pbaelf.Block{}
+	if err := readOnlyBlk.Payload.UnmarshalTo(block); err != nil {
+		return nil, fmt.Errorf("unmarshal block #%d payload: %w", readOnlyBlk.Number, err)
+	}
+	return block, nil
+}
+
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[value] = true
+	}
+	return set
+}
+
+func joinSet(set map[string]bool) string {
+	values := make([]string, 0, len(set))
+	for value := range set {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+	return strings.Join(values, ", ")
+}
@@ -0,0 +1,111 @@
+package transform
+
+import (
+	"testing"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+	pbtransform "github.com/streamingfast/firehose-aelf/pb/sf/aelf/transform/v1"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"github.com/test-go/testify/assert"
+	"github.com/test-go/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestCallFilter_Transform(t *testing.T) {
+	block := &pbaelf.Block{
+		Height: 10,
+		TransactionTraces: []*pbaelf.TransactionTrace{
+			{TransactionId: "transfer", Calls: []*pbaelf.Call{
+				{To: "token", MethodName: "Transfer", ExecutionStatus: pbaelf.ExecutionStatus_EXECUTED, Logs: []*pbaelf.LogEvent{{Name: "Transferred"}}},
+			}},
+			{TransactionId: "failed-transfer", Calls: []*pbaelf.Call{
+				{To: "token", MethodName: "Transfer", ExecutionStatus: pbaelf.ExecutionStatus_CONTRACT_ERROR},
+			}},
+			{TransactionId: "no-transaction", Calls: []*pbaelf.Call{
+				{ExecutionStatus: pbaelf.ExecutionStatus_EXECUTED, Logs: []*pbaelf.LogEvent{{}}},
+			}},
+			{TransactionId: "inline-transfer", Calls: []*pbaelf.Call{
+				{To: "dex", MethodName: "Swap", ExecutionStatus: pbaelf.ExecutionStatus_EXECUTED},
+				{To: "token", MethodName: "TransferFrom", ExecutionStatus: pbaelf.ExecutionStatus_EXECUTED, IsReverted: true, Logs: []*pbaelf.LogEvent{{Name: "Transferred"}}},
+			}},
+		},
+	}
+	payload, err := anypb.New(block)
+	require.NoError(t, err)
+	blk := &pbbstream.Block{Number: 10, Payload: payload}
+
+	tests := []struct {
+		name     string
+		filter   *pbtransform.CallFilter
+		expected []string
+	}{
+		{"address", &pbtransform.CallFilter{Addresses: []string{"token"}}, []string{"transfer", "failed-transfer", "inline-transfer"}},
+		{"address and method", &pbtransform.CallFilter{Addresses: []string{"token"}, Methods: []string{"Swap"}}, nil},
+		{"methods", &pbtransform.CallFilter{Methods: []string{"Swap", "Transfer"}}, []string{"transfer", "failed-transfer", "inline-transfer"}},
+		{"event", &pbtransform.CallFilter{Events: []string{"Transferred"}}, []string{"transfer", "inline-transfer"}},
+		{"succeeded", &pbtransform.CallFilter{Addresses: []string{"token"}, Status: pbtransform.CallFilter_SUCCEEDED}, []string{"transfer"}},
+		{"failed", &pbtransform.CallFilter{Addresses: []string{"token"}, Status: pbtransform.CallFilter_FAILED}, []string{"failed-transfer", "inline-transfer"}},
+		{"empty address", &pbtransform.CallFilter{Addresses: []string{""}}, nil},
+		{"empty method", &pbtransform.CallFilter{Methods: []string{""}}, nil},
+		{"empty event", &pbtransform.CallFilter{Events: []string{""}}, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output, err := newCallFilter(test.filter, nil, nil).Transform(blk, transform.NewNilObj())
+			require.NoError(t, err)
+
+			var ids []string
+			for _, trace := range output.(*pbaelf.Block).TransactionTraces {
+				ids = append(ids, trace.TransactionId)
+			}
+			assert.Equal(t, test.expected, ids)
+		})
+	}
+}
+
+func TestCallFilter_GetIndexProvider(t *testing.T) {
+	indexStore, err := dstore.NewStore(t.TempDir(), "", "", false)
+	require.NoError(t, err)
+
+	indexer := NewAElfBlockIndexer(indexStore, 10)
+	for height := int64(10); height <= 20; height++ {
+		var calls []*pbaelf.Call
+		switch height {
+		case 12:
+			calls = []*pbaelf.Call{{To: "token", MethodName: "Transfer", Logs: []*pbaelf.LogEvent{{Name: "Transferred", Address: "token"}}}}
+		case 15:
+			calls = []*pbaelf.Call{{To: "token", MethodName: "Approve"}}
+		case 17:
+			calls = []*pbaelf.Call{{To: "dex", MethodName: "Transfer"}}
+		}
+		require.NoError(t, indexer.ProcessBlock(&pbaelf.Block{Height: height, TransactionTraces: []*pbaelf.TransactionTrace{{Calls: calls}}}))
+	}
+
+	tests := []struct {
+		name     string
+		filter   *pbtransform.CallFilter
+		expected []uint64
+	}{
+		{"address", &pbtransform.CallFilter{Addresses: []string{"token"}}, []uint64{12, 15}},
+		{"addresses", &pbtransform.CallFilter{Addresses: []string{"token", "dex"}}, []uint64{12, 15, 17}},
+		{"address and method", &pbtransform.CallFilter{Addresses: []string{"token"}, Methods: []string{"Transfer"}}, []uint64{12}},
+		{"event", &pbtransform.CallFilter{Events: []string{"Transferred"}}, []uint64{12}},
+		{"unknown", &pbtransform.CallFilter{Methods: []string{"Unknown"}}, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider := newCallFilter(test.filter, indexStore, []uint64{10}).GetIndexProvider()
+			require.NotNil(t, provider)
+
+			blocks, err := provider.BlocksInRange(10, 10)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, blocks)
+		})
+	}
+
+	assert.Nil(t, newCallFilter(&pbtransform.CallFilter{Status: pbtransform.CallFilter_FAILED}, indexStore, []uint64{10}).GetIndexProvider())
+}
@@ -0,0 +1,80 @@
+package transform
+
+import (
+	"fmt"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+	pbtransform "github.com/streamingfast/firehose-aelf/pb/sf/aelf/transform/v1"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+var HeaderOnlyMessageName = proto.MessageName(&pbtransform.HeaderOnly{})
+
+func HeaderOnlyFactory(indexStore dstore.Store, possibleIndexSizes []uint64) (*transform.Factory, error) {
+	return &transform.Factory{
+		Obj: &pbtransform.HeaderOnly{},
+		NewFunc: func(message *anypb.Any) (transform.Transform, error) {
+			mname := message.MessageName()
+			if mname != HeaderOnlyMessageName {
+				return nil, fmt.Errorf("expected type url %q, received %q", HeaderOnlyMessageName, message.TypeUrl)
+			}
+
+			filter := &pbtransform.HeaderOnly{}
+			if err := proto.Unmarshal(message.Value, filter); err != nil {
+				return nil, fmt.Errorf("unexpected unmarshal error: %w", err)
+			}
+
+			return &HeaderOnlyFilter{WithTransactionStatuses: filter.WithTransactionStatuses}, nil
+		},
+	}, nil
+}
+
+type HeaderOnlyFilter struct {
+	WithTransactionStatuses bool
+}
+
+func (f *HeaderOnlyFilter) String() string {
+	return fmt.Sprintf("HeaderOnly{with_transaction_statuses: %t}", f.WithTransactionStatuses)
+}
+
+func (f *HeaderOnlyFilter) Transform(readOnlyBlk *pbbstream.Block, in transform.Input) (transform.Output, error) {
+	block, err := inputBlock(readOnlyBlk, in)
+	if err != nil {
+		return nil, err
+	}
+
+	var traces []*pbaelf.TransactionTrace
+	if f.WithTransactionStatuses {
+		traces = make([]*pbaelf.TransactionTrace, 0, len(block.TransactionTraces))
+		for _, trace := range block.TransactionTraces {
+			traces = append(traces, lightTransactionTrace(trace))
+		}
+	}
+	block.TransactionTraces = traces
+
+	if block.ConsensusTransition != nil {
+		block.ConsensusTransition.Logs = nil
+	}
+	return block, nil
+}
+
+// lightTransactionTrace reduces trace to its id, kind and a main call holding only its path and status.
+func lightTransactionTrace(trace *pbaelf.TransactionTrace) *pbaelf.TransactionTrace {
+	light := &pbaelf.TransactionTrace{
+		TransactionId: trace.TransactionId,
+		Kind:          trace.Kind,
+	}
+	if int(trace.MainCallIndex) < len(trace.Calls) {
+		mainCall := trace.Calls[trace.MainCallIndex]
+		light.Calls = []*pbaelf.Call{{
+			CallPath:        mainCall.CallPath,
+			ExecutionStatus: mainCall.ExecutionStatus,
+			IsReverted:      mainCall.IsReverted,
+		}}
+	}
+	return light
+}
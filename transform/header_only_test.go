@@ -0,0 +1,48 @@
+package transform
+
+import (
+	"testing"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/bstream/transform"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"github.com/test-go/testify/assert"
+	"github.com/test-go/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestHeaderOnlyFilter_Transform(t *testing.T) {
+	block := &pbaelf.Block{
+		Height: 10,
+		Header: &pbaelf.BlockHeader{Height: 10, Consensus: &pbaelf.ConsensusInfo{RoundNumber: 7}},
+		TransactionTraces: []*pbaelf.TransactionTrace{
+			{TransactionId: "next-round", Kind: pbaelf.TransactionKind_SYSTEM_CONSENSUS, RawTransaction: []byte{0x01}, MainCallIndex: 1, Calls: []*pbaelf.Call{
+				{CallPath: ":0:pre:0", MethodName: "ChargeTransactionFees", ExecutionStatus: pbaelf.ExecutionStatus_EXECUTED},
+				{CallPath: ":0", MethodName: "NextRound", ExecutionStatus: pbaelf.ExecutionStatus_EXECUTED, IsReverted: true, Logs: []*pbaelf.LogEvent{{Name: "MiningInformationUpdated"}}},
+			}},
+		},
+		ConsensusTransition: &pbaelf.ConsensusTransition{RoundNumber: 8, Logs: []*pbaelf.LogEvent{{Name: "MiningInformationUpdated"}}},
+		Stats:               &pbaelf.BlockStats{TransactionCount: 1},
+	}
+	payload, err := anypb.New(block)
+	require.NoError(t, err)
+	blk := &pbbstream.Block{Number: 10, Payload: payload}
+
+	output, err := (&HeaderOnlyFilter{}).Transform(blk, transform.NewNilObj())
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(&pbaelf.Block{
+		Height:              10,
+		Header:              block.Header,
+		ConsensusTransition: &pbaelf.ConsensusTransition{RoundNumber: 8},
+		Stats:               block.Stats,
+	}, output.(*pbaelf.Block)))
+
+	output, err = (&HeaderOnlyFilter{WithTransactionStatuses: true}).Transform(blk, transform.NewNilObj())
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(&pbaelf.TransactionTrace{
+		TransactionId: "next-round",
+		Kind:          pbaelf.TransactionKind_SYSTEM_CONSENSUS,
+		Calls:         []*pbaelf.Call{{CallPath: ":0", ExecutionStatus: pbaelf.ExecutionStatus_EXECUTED, IsReverted: true}},
+	}, output.(*pbaelf.Block).TransactionTraces[0]))
+}
@@ -0,0 +1,83 @@
+package transform
+
+import (
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+)
+
+// IndexerShortName is the short name of the block index files, they are named `<base>.<size>.calls.idx`
+const IndexerShortName = "calls"
+
+// Index keys are prefixed by their kind so an address called as contract and emitting logs, or a method
+// and an event sharing the same name, are indexed separately
+const (
+	contractKeyPrefix   = "to:"
+	methodKeyPrefix     = "method:"
+	eventKeyPrefix      = "event:"
+	logAddressKeyPrefix = "log:"
+)
+
+// ContractKey is the index key of blocks with a call to contract address (`Call.to`).
+func ContractKey(address string) string { return contractKeyPrefix + address }
+
+// MethodKey is the index key of blocks with a call to method name (`Call.method_name`).
+func MethodKey(name string) string { return methodKeyPrefix + name }
+
+// EventKey is the index key of blocks with a log event named name (`LogEvent.name`).
+func EventKey(name string) string { return eventKeyPrefix + name }
+
+// LogAddressKey is the index key of blocks with a log event emitted by address (`LogEvent.address`).
+func LogAddressKey(address string) string { return logAddressKeyPrefix + address }
+
+// AElfBlockIndexer builds bstream block indexes keyed by contract address, method name, log event name and
+// log address of every call of the block, reverted calls included.
+type AElfBlockIndexer struct {
+	BlockIndexer *transform.BlockIndexer
+}
+
+func NewAElfBlockIndexer(indexStore dstore.Store, indexSize uint64, opts ...transform.Option) *AElfBlockIndexer {
+	return &AElfBlockIndexer{
+		BlockIndexer: transform.NewBlockIndexer(indexStore, indexSize, IndexerShortName, opts...),
+	}
+}
+
+// ProcessBlock adds the keys of block to the current index, which is written to the store once a block
+// past its upper boundary is processed.
+func (i *AElfBlockIndexer) ProcessBlock(block *pbaelf.Block) error {
+	i.BlockIndexer.Add(BlockKeys(block), uint64(block.Height))
+	return nil
+}
+
+// BlockKeys returns the deduplicated index keys of block.
+func BlockKeys(block *pbaelf.Block) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	add := func(key string) {
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+
+	// A call without transaction has no address nor method, empty values are not indexed
+	for _, trace := range block.TransactionTraces {
+		for _, call := range trace.Calls {
+			if call.To != "" {
+				add(ContractKey(call.To))
+			}
+			if call.MethodName != "" {
+				add(MethodKey(call.MethodName))
+			}
+			for _, event := range call.Logs {
+				if event.Name != "" {
+					add(EventKey(event.Name))
+				}
+				if event.Address != "" {
+					add(LogAddressKey(event.Address))
+				}
+			}
+		}
+	}
+	return keys
+}
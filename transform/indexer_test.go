@@ -0,0 +1,37 @@
+package transform
+
+import (
+	"testing"
+
+	pbaelf "github.com/streamingfast/firehose-aelf/pb/sf/aelf/type/v1"
+	"github.com/test-go/testify/assert"
+)
+
+func TestBlockKeys(t *testing.T) {
+	block := &pbaelf.Block{
+		TransactionTraces: []*pbaelf.TransactionTrace{
+			{Calls: []*pbaelf.Call{
+				{To: "token", MethodName: "Transfer", Logs: []*pbaelf.LogEvent{{Name: "Transferred", Address: "token"}}},
+				{To: "token", MethodName: "ChargeTransactionFees", Logs: []*pbaelf.LogEvent{{Name: "TransactionFeeCharged", Address: "token"}}},
+			}},
+			{Calls: []*pbaelf.Call{
+				{To: "consensus", MethodName: "UpdateTinyBlockInformation"},
+			}},
+			// Call without transaction
+			{Calls: []*pbaelf.Call{
+				{Logs: []*pbaelf.LogEvent{{}}},
+			}},
+		},
+	}
+
+	assert.Equal(t, []string{
+		"to:token",
+		"method:Transfer",
+		"event:Transferred",
+		"log:token",
+		"method:ChargeTransactionFees",
+		"event:TransactionFeeCharged",
+		"to:consensus",
+		"method:UpdateTinyBlockInformation",
+	}, BlockKeys(block))
+}